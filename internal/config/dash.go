@@ -16,6 +16,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	internalErr "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -79,6 +80,8 @@ func (c *CRDWatchConfig) CanPerform(u *unstructured.Unstructured) bool {
 type Dash interface {
 	ObjectPath(namespace, apiVersion, kind, name string) (string, error)
 
+	PathResolver() link.PathResolver
+
 	ClusterClient() cluster.ClientInterface
 
 	CRDWatcher() CRDWatcher
@@ -122,6 +125,7 @@ type Live struct {
 	currentContextName string
 	restConfigOptions  cluster.RESTConfigOptions
 	buildInfo          BuildInfo
+	pathResolver       link.PathResolver
 }
 
 var _ Dash = (*Live)(nil)
@@ -167,6 +171,16 @@ func (l *Live) ObjectPath(namespace, apiVersion, kind, name string) (string, err
 	return l.moduleManager.ObjectPath(namespace, apiVersion, kind, name)
 }
 
+// PathResolver returns the resolver printed links resolve paths with before ObjectPath.
+func (l *Live) PathResolver() link.PathResolver {
+	return l.pathResolver
+}
+
+// SetPathResolver sets the resolver printed links resolve paths with before ObjectPath.
+func (l *Live) SetPathResolver(resolver link.PathResolver) {
+	l.pathResolver = resolver
+}
+
 // ClusterClient returns a cluster client.
 func (l *Live) ClusterClient() cluster.ClientInterface {
 	return l.clusterClient
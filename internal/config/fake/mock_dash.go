@@ -13,6 +13,7 @@ import (
 	cluster "github.com/vmware-tanzu/octant/internal/cluster"
 	config "github.com/vmware-tanzu/octant/internal/config"
 	errors "github.com/vmware-tanzu/octant/internal/errors"
+	link "github.com/vmware-tanzu/octant/internal/link"
 	module "github.com/vmware-tanzu/octant/internal/module"
 	portforward "github.com/vmware-tanzu/octant/internal/portforward"
 	log "github.com/vmware-tanzu/octant/pkg/log"
@@ -200,6 +201,20 @@ func (mr *MockDashMockRecorder) ObjectStore() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockDash)(nil).ObjectStore))
 }
 
+// PathResolver mocks base method
+func (m *MockDash) PathResolver() link.PathResolver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PathResolver")
+	ret0, _ := ret[0].(link.PathResolver)
+	return ret0
+}
+
+// PathResolver indicates an expected call of PathResolver
+func (mr *MockDashMockRecorder) PathResolver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PathResolver", reflect.TypeOf((*MockDash)(nil).PathResolver))
+}
+
 // PluginManager mocks base method
 func (m *MockDash) PluginManager() plugin.ManagerInterface {
 	m.ctrl.T.Helper()
@@ -33,3 +33,8 @@ func PtrInt64(i int64) *int64 {
 func PtrBool(b bool) *bool {
 	return &b
 }
+
+// PtrString converts string to *string
+func PtrString(s string) *string {
+	return &s
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// CompareConfig is configuration for Compare.
+type CompareConfig struct {
+	// Path is the path of the comparison. The names of the objects compared follow it.
+	Path       string
+	Title      string
+	StoreKey   store.Key
+	ObjectType func() interface{}
+	RootPath   ResourceLink
+}
+
+// Compare describes two objects of a kind side by side.
+type Compare struct {
+	*base
+
+	path           string
+	title          string
+	objectStoreKey store.Key
+	objectType     func() interface{}
+	rootPath       ResourceLink
+}
+
+var _ Describer = (*Compare)(nil)
+
+// NewCompare creates an instance of Compare.
+func NewCompare(c CompareConfig) *Compare {
+	return &Compare{
+		base:           newBaseDescriber(),
+		path:           c.Path,
+		title:          c.Title,
+		objectStoreKey: c.StoreKey,
+		objectType:     c.ObjectType,
+		rootPath:       c.RootPath,
+	}
+}
+
+// Describe compares the objects named by the a and b fields. Without them, e.g. when a
+// section describes its children, there is nothing to compare.
+func (d *Compare) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
+	nameA, nameB := options.Fields["a"], options.Fields["b"]
+	if nameA == "" || nameB == "" {
+		return component.EmptyContentResponse, nil
+	}
+
+	title := getBreadcrumb(d.rootPath, d.title, "", namespace)
+	title = append(title, component.NewText(fmt.Sprintf("%s and %s", nameA, nameB)))
+	cr := component.NewContentResponse(title)
+
+	a, err := d.loadObject(ctx, namespace, nameA, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	b, err := d.loadObject(ctx, namespace, nameB, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	view, err := printer.CompareHandler(a, b, printer.Options{DashConfig: options.Dash, Link: options.Link})
+	if err != nil {
+		cr.Add(CreateErrorTab("Error", fmt.Errorf("compare %s and %s: %w", nameA, nameB, err)))
+		return *cr, nil
+	}
+
+	view.SetAccessor("compare")
+	cr.Add(view)
+
+	return *cr, nil
+}
+
+func (d *Compare) loadObject(ctx context.Context, namespace, name string, options Options) (runtime.Object, error) {
+	object, err := options.LoadObject(ctx, namespace, map[string]string{"name": name}, d.objectStoreKey)
+	if err != nil {
+		return nil, api.NewNotFoundError(path.Join(d.path, name))
+	}
+
+	item := d.objectType()
+	if err := kubernetes.FromUnstructured(object, item); err != nil {
+		return nil, fmt.Errorf("converting dynamic object to a type: %w", err)
+	}
+
+	runtimeObject, ok := item.(runtime.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected item to be a runtime object. It was a %T", item)
+	}
+
+	return runtimeObject, nil
+}
+
+// PathFilters returns the path filters for this comparison.
+func (d *Compare) PathFilters() []PathFilter {
+	return []PathFilter{
+		*NewPathFilter(path.Join(d.path, "(?P<a>[^/]+)", "(?P<b>[^/]+)"), d),
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestCompare(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	newRevision := func(name, image string) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ControllerRevision"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Data: runtime.RawExtension{
+				Raw: []byte(`{"spec": {"template": {"spec": {"containers": [{"name": "app", "image": "` + image + `"}]}}}}`),
+			},
+		}
+	}
+
+	revisions := map[string]*appsv1.ControllerRevision{
+		"web-1": newRevision("web-1", "nginx:1.14"),
+		"web-2": newRevision("web-2", "nginx:1.15"),
+	}
+
+	key := store.Key{APIVersion: "apps/v1", Kind: "ControllerRevision"}
+
+	d := NewCompare(CompareConfig{
+		Path:       "/compare/controller-revisions",
+		Title:      "Controller Revisions",
+		StoreKey:   key,
+		ObjectType: func() interface{} { return &appsv1.ControllerRevision{} },
+		RootPath:   ResourceLink{Title: "Workloads", Url: "/overview/namespace/($NAMESPACE)/workloads"},
+	})
+
+	filters := d.PathFilters()
+	require.Len(t, filters, 1)
+
+	contentPath := "/namespace/default/compare/controller-revisions/web-1/web-2"
+	require.True(t, filters[0].Match(contentPath))
+
+	fields := filters[0].Fields(contentPath)
+	require.Equal(t, map[string]string{"namespace": "default", "a": "web-1", "b": "web-2"}, fields)
+
+	options := Options{
+		Dash:   configFake.NewMockDash(controller),
+		Fields: fields,
+		LoadObject: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error) {
+			require.Equal(t, "default", namespace)
+			require.Equal(t, key, objectStoreKey)
+			return testutil.ToUnstructured(t, revisions[fields["name"]]), nil
+		},
+	}
+
+	got, err := d.Describe(ctx, "default", options)
+	require.NoError(t, err)
+
+	view, err := printer.CompareHandler(revisions["web-1"], revisions["web-2"], printer.Options{})
+	require.NoError(t, err)
+	view.SetAccessor("compare")
+
+	expected := component.NewContentResponse(component.Title(
+		component.NewLink("", "Workloads", "/overview/namespace/default/workloads"),
+		component.NewLink("", "Controller Revisions", ""),
+		component.NewText("web-1 and web-2"),
+	))
+	expected.Add(view)
+
+	testutil.AssertJSONEqual(t, expected, &got)
+
+	empty, err := d.Describe(ctx, "default", Options{})
+	require.NoError(t, err)
+	require.Equal(t, component.EmptyContentResponse, empty)
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kLabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/config"
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
@@ -125,6 +126,19 @@ type Options struct {
 
 	LoadObjects func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
 	LoadObject  func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
+
+	// DefaultSection maps kinds to the accessor of the tab which is active when their
+	// objects load, e.g. yaml. The empty GroupVersionKind sets the default for every kind.
+	// If a kind has no default, the first tab is active.
+	DefaultSection map[schema.GroupVersionKind]string
+}
+
+// defaultSection returns the accessor of the tab which is active when an object loads.
+func (o Options) defaultSection(object runtime.Object) string {
+	if section, ok := o.DefaultSection[object.GetObjectKind().GroupVersionKind()]; ok {
+		return section
+	}
+	return o.DefaultSection[schema.GroupVersionKind{}]
 }
 
 // Describer creates content.
@@ -12,6 +12,7 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -192,6 +193,15 @@ func initNamespacedOverview() *Section {
 		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
 	})
 
+	csLeases := NewResource(ResourceOptions{
+		Path:           "/config-and-storage/leases",
+		ObjectStoreKey: store.Key{APIVersion: "coordination.k8s.io/v1", Kind: "Lease"},
+		ListType:       &coordinationv1.LeaseList{},
+		ObjectType:     &coordinationv1.Lease{},
+		Titles:         ResourceTitle{List: "Leases", Object: "Leases"},
+		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
+	})
+
 	csPVCs := NewResource(ResourceOptions{
 		Path:           "/config-and-storage/persistent-volume-claims",
 		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
@@ -223,6 +233,7 @@ func initNamespacedOverview() *Section {
 		"/config-and-storage",
 		"Config and Storage",
 		csConfigMaps,
+		csLeases,
 		csPVCs,
 		csSecrets,
 		csServiceAccounts,
@@ -262,6 +273,14 @@ func initNamespacedOverview() *Section {
 		DisableResourceViewer: true,
 	})
 
+	compareControllerRevisions := NewCompare(CompareConfig{
+		Path:       "/compare/controller-revisions",
+		Title:      "Controller Revisions",
+		StoreKey:   store.Key{APIVersion: "apps/v1", Kind: "ControllerRevision"},
+		ObjectType: func() interface{} { return &appsv1.ControllerRevision{} },
+		RootPath:   ResourceLink{Title: "Workloads", Url: "/overview/namespace/($NAMESPACE)/workloads"},
+	})
+
 	rootDescriber := NewSection(
 		"/",
 		"Overview",
@@ -271,6 +290,7 @@ func initNamespacedOverview() *Section {
 		NamespacedCRD(),
 		rbacDescriber,
 		eventsDescriber,
+		compareControllerRevisions,
 	)
 
 	return rootDescriber
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespacedOverview_PathFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentPath string
+		fields      map[string]string
+	}{
+		{
+			name:        "lease list",
+			contentPath: "/namespace/default/config-and-storage/leases",
+			fields:      map[string]string{"namespace": "default"},
+		},
+		{
+			name:        "lease",
+			contentPath: "/namespace/default/config-and-storage/leases/lease",
+			fields:      map[string]string{"namespace": "default", "name": "lease"},
+		},
+	}
+
+	filters := NamespacedOverview().PathFilters()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var matched []PathFilter
+			for _, filter := range filters {
+				if filter.Match(test.contentPath) {
+					matched = append(matched, filter)
+				}
+			}
+
+			if assert.Len(t, matched, 1) {
+				assert.Equal(t, test.fields, matched[0].Fields(test.contentPath))
+			}
+		})
+	}
+}
@@ -43,10 +43,17 @@ func CustomResourceSummaryTab(crdName string) TabFactory {
 			return nil, fmt.Errorf("create link generator: %w", err)
 		}
 
+		customResourceHandlers := printer.NewCustomResourceHandlers()
+		if err := printer.AddCustomResourceHandlers(customResourceHandlers); err != nil {
+			return nil, fmt.Errorf("add custom resource handlers: %w", err)
+		}
+
 		printOptions := printer.Options{
-			DashConfig:    options,
-			Link:          linkGenerator,
-			ObjectFactory: printer.NewDefaultObjectFactory(),
+			DashConfig:             options,
+			Link:                   linkGenerator,
+			ObjectFactory:          printer.NewDefaultObjectFactory(),
+			CustomResourceHandlers: customResourceHandlers,
+			PathResolver:           options.PathResolver(),
 		}
 
 		return printer.CustomResourceHandler(ctx, crd, cr, printOptions)
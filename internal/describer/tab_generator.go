@@ -110,9 +110,30 @@ func (t ObjectTabsGenerator) Generate(ctx context.Context, config TabsGeneratorC
 		}
 	}
 
+	setActiveTab(list, config.Options.defaultSection(config.Object))
+
 	return list, nil
 }
 
+// setActiveTab marks the tab with the accessor as active. If no tab has the accessor, no
+// tab is marked and the first tab is active.
+func setActiveTab(tabs []component.Component, accessor string) {
+	if accessor == "" {
+		return
+	}
+
+	for _, tab := range tabs {
+		metadata := tab.GetMetadata()
+		if metadata.Accessor != accessor {
+			continue
+		}
+
+		metadata.Active = true
+		tab.SetMetadata(metadata)
+		return
+	}
+}
+
 // CreateErrorTab creates an error tab given a name and an error.
 func CreateErrorTab(name string, err error) component.Component {
 	errComponent := component.NewError(component.TitleFromString(name), err)
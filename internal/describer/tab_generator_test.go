@@ -13,7 +13,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -57,3 +59,73 @@ func TestCreateErrorTab(t *testing.T) {
 
 	testutil.AssertJSONEqual(t, wanted, actual)
 }
+
+func TestObjectTabsGenerator_Generate_activeTab(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	tests := []struct {
+		name           string
+		object         runtime.Object
+		defaultSection map[schema.GroupVersionKind]string
+		expected       string
+	}{
+		{
+			name:   "no default section",
+			object: testutil.CreatePod("pod"),
+		},
+		{
+			name:           "default section for kind",
+			object:         testutil.CreatePod("pod"),
+			defaultSection: map[schema.GroupVersionKind]string{gvk.Pod: "yaml", {}: "metadata"},
+			expected:       "yaml",
+		},
+		{
+			name:           "default section for every kind",
+			object:         deployment,
+			defaultSection: map[schema.GroupVersionKind]string{gvk.Pod: "yaml", {}: "metadata"},
+			expected:       "metadata",
+		},
+		{
+			name:           "default section without a tab",
+			object:         testutil.CreatePod("pod"),
+			defaultSection: map[schema.GroupVersionKind]string{gvk.Pod: "logs"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewObjectTabsGenerator()
+
+			tabsFactory := func() ([]Tab, error) {
+				var tabs []Tab
+				for _, accessor := range []string{"summary", "metadata", "yaml"} {
+					accessor := accessor
+					tabs = append(tabs, Tab{
+						Name: accessor,
+						Factory: func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+							c := component.NewText(accessor)
+							c.SetAccessor(accessor)
+							return c, nil
+						},
+					})
+				}
+				return tabs, nil
+			}
+
+			config := TabsGeneratorConfig{
+				Object:      test.object,
+				TabsFactory: tabsFactory,
+				Options:     Options{DefaultSection: test.defaultSection},
+			}
+
+			actual, err := g.Generate(context.Background(), config)
+			require.NoError(t, err)
+			require.Len(t, actual, 3)
+
+			for _, tab := range actual {
+				metadata := tab.GetMetadata()
+				require.Equal(t, metadata.Accessor == test.expected, metadata.Active, metadata.Accessor)
+			}
+		})
+	}
+}
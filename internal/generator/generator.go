@@ -98,7 +98,7 @@ func (g *Generator) Generate(ctx context.Context, contentPath string, opts Optio
 		Printer:  g.printer,
 		LabelSet: opts.LabelSet,
 		Dash:     g.dashConfig,
-		Link:     linkGenerator,
+		Link:     link.NewWithPathResolver(linkGenerator, g.dashConfig.PathResolver()),
 
 		LoadObjects: loaderFactory.LoadObjects,
 		LoadObject:  loaderFactory.LoadObject,
@@ -78,6 +78,7 @@ func Test_realGenerator_Generate(t *testing.T) {
 
 			objectStore := objectStoreFake.NewMockStore(controller)
 			dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+			dashConfig.EXPECT().PathResolver().Return(nil).AnyTimes()
 
 			ctx := context.Background()
 			pathMatcher := describer.NewPathMatcher("module")
@@ -20,14 +20,21 @@ var (
 	ConfigMap                      = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
 	CronJob                        = schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
 	CustomResourceDefinition       = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+	DeviceClass                    = schema.GroupVersionKind{Group: "resource.k8s.io", Version: "v1", Kind: "DeviceClass"}
+	DeviceClassV1Beta1             = schema.GroupVersionKind{Group: "resource.k8s.io", Version: "v1beta1", Kind: "DeviceClass"}
 	DaemonSet                      = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
 	Deployment                     = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
 	ExtDeployment                  = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}
 	ExtReplicaSet                  = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "ReplicaSet"}
 	Event                          = schema.GroupVersionKind{Version: "v1", Kind: "Event"}
+	Gateway                        = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "Gateway"}
+	GatewayV1Beta1                 = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1beta1", Kind: "Gateway"}
+	HTTPRoute                      = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+	HTTPRouteV1Beta1               = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1beta1", Kind: "HTTPRoute"}
 	HorizontalPodAutoscaler        = schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"}
 	Ingress                        = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
 	Job                            = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	Lease                          = schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}
 	MutatingWebhookConfiguration   = schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"}
 	Node                           = schema.GroupVersionKind{Version: "v1", Kind: "Node"}
 	Namespace                      = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
@@ -39,13 +46,44 @@ var (
 	PodMetrics                     = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetrics"}
 	PersistentVolume               = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolume"}
 	PersistentVolumeClaim          = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
+	ResourceClaim                  = schema.GroupVersionKind{Group: "resource.k8s.io", Version: "v1", Kind: "ResourceClaim"}
+	ResourceClaimV1Beta1           = schema.GroupVersionKind{Group: "resource.k8s.io", Version: "v1beta1", Kind: "ResourceClaim"}
 	ReplicationController          = schema.GroupVersionKind{Version: "v1", Kind: "ReplicationController"}
+	RuntimeClass                   = schema.GroupVersionKind{Group: "node.k8s.io", Version: "v1", Kind: "RuntimeClass"}
 	StatefulSet                    = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
 	RoleBinding                    = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"}
 	Role                           = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"}
 	ValidatingWebhookConfiguration = schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"}
+	VerticalPodAutoscaler          = schema.GroupVersionKind{Group: "autoscaling.k8s.io", Version: "v1", Kind: "VerticalPodAutoscaler"}
 )
 
+// clusterScoped are the group kinds known to be cluster scoped.
+var clusterScoped = map[schema.GroupKind]bool{
+	APIService.GroupKind():                                            true,
+	ClusterRole.GroupKind():                                           true,
+	ClusterRoleBinding.GroupKind():                                    true,
+	CustomResourceDefinition.GroupKind():                              true,
+	DeviceClass.GroupKind():                                           true,
+	MutatingWebhookConfiguration.GroupKind():                          true,
+	Namespace.GroupKind():                                             true,
+	Node.GroupKind():                                                  true,
+	PersistentVolume.GroupKind():                                      true,
+	RuntimeClass.GroupKind():                                          true,
+	ValidatingWebhookConfiguration.GroupKind():                        true,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:               true,
+	{Group: "storage.k8s.io", Kind: "CSIDriver"}:                      true,
+	{Group: "storage.k8s.io", Kind: "CSINode"}:                        true,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                   true,
+	{Group: "storage.k8s.io", Kind: "VolumeAttachment"}:               true,
+	{Group: "certificates.k8s.io", Kind: "CertificateSigningRequest"}: true,
+}
+
+// IsClusterScoped returns true if a kind is known to be cluster scoped. Kinds which aren't
+// known, e.g. custom resources, are not cluster scoped.
+func IsClusterScoped(apiVersion, kind string) bool {
+	return clusterScoped[schema.FromAPIVersionAndKind(apiVersion, kind).GroupKind()]
+}
+
 // CustomResource generates a `schema.GroupVersionKind` for a custom resource given a version.
 func CustomResource(crd *unstructured.Unstructured, version string) (schema.GroupVersionKind, error) {
 	if crd == nil {
@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -73,7 +74,7 @@ func (l *Link) ForObjectWithQuery(object runtime.Object, text string, query url.
 
 // ForGVK returns a link component referencing an object
 func (l *Link) ForGVK(namespace, apiVersion, kind, name, text string) (*component.Link, error) {
-	p, err := l.objectPathFn(namespace, apiVersion, kind, name)
+	p, err := l.objectPath(namespace, apiVersion, kind, name)
 	if err != nil {
 		return nil, err
 	}
@@ -103,31 +104,50 @@ func (l *Link) ForOwner(parent runtime.Object, controllerRef *metav1.OwnerRefere
 }
 
 func (l *Link) extractPathFromObject(object runtime.Object) (string, error) {
+	namespace, apiVersion, kind, name, err := objectFields(object)
+	if err != nil {
+		return "", err
+	}
+
+	return l.objectPath(namespace, apiVersion, kind, name)
+}
+
+// objectPath returns the path for an object. Cluster scoped objects are never in a namespace,
+// so the namespace isn't used for them. This allows namespaced objects to link to cluster
+// scoped owners and references using their own namespace.
+func (l *Link) objectPath(namespace, apiVersion, kind, name string) (string, error) {
+	return l.objectPathFn(scopedNamespace(namespace, apiVersion, kind), apiVersion, kind, name)
+}
+
+func scopedNamespace(namespace, apiVersion, kind string) string {
+	if gvk.IsClusterScoped(apiVersion, kind) {
+		return ""
+	}
+	return namespace
+}
+
+func objectFields(object runtime.Object) (namespace, apiVersion, kind, name string, err error) {
 	if object == nil {
-		return "", errors.New("can't generate path for nil object")
+		return "", "", "", "", errors.New("can't generate path for nil object")
 	}
 
 	accessor := meta.NewAccessor()
 
-	namespace, err := accessor.Namespace(object)
-	if err != nil {
-		return "", err
+	if namespace, err = accessor.Namespace(object); err != nil {
+		return "", "", "", "", err
 	}
 
-	apiVersion, err := accessor.APIVersion(object)
-	if err != nil {
-		return "", err
+	if apiVersion, err = accessor.APIVersion(object); err != nil {
+		return "", "", "", "", err
 	}
 
-	kind, err := accessor.Kind(object)
-	if err != nil {
-		return "", err
+	if kind, err = accessor.Kind(object); err != nil {
+		return "", "", "", "", err
 	}
 
-	name, err := accessor.Name(object)
-	if err != nil {
-		return "", err
+	if name, err = accessor.Name(object); err != nil {
+		return "", "", "", "", err
 	}
 
-	return l.objectPathFn(namespace, apiVersion, kind, name)
+	return namespace, apiVersion, kind, name, nil
 }
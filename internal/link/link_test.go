@@ -139,3 +139,75 @@ func TestLink_ForOwner(t *testing.T) {
 	assert.Equal(t, expectedRef, got.Ref())
 	assert.Equal(t, "name", got.Text())
 }
+
+func TestLink_scope(t *testing.T) {
+	tests := []struct {
+		name              string
+		namespace         string
+		apiVersion        string
+		kind              string
+		expectedNamespace string
+	}{
+		{
+			name:              "namespaced object",
+			namespace:         "default",
+			apiVersion:        "v1",
+			kind:              "Pod",
+			expectedNamespace: "default",
+		},
+		{
+			name:       "cluster scoped object",
+			apiVersion: "v1",
+			kind:       "Node",
+		},
+		{
+			name:       "cluster scoped object with namespace",
+			namespace:  "default",
+			apiVersion: "rbac.authorization.k8s.io/v1",
+			kind:       "ClusterRole",
+		},
+		{
+			name:              "unknown object",
+			namespace:         "default",
+			apiVersion:        "stable.example.com/v1",
+			kind:              "CronTab",
+			expectedNamespace: "default",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			config := fake.NewMockConfig(controller)
+
+			config.EXPECT().
+				ObjectPath(
+					gomock.Eq(test.expectedNamespace),
+					gomock.Eq(test.apiVersion),
+					gomock.Eq(test.kind),
+					gomock.Eq("name")).
+				Return("/path", nil).
+				Times(2)
+
+			l, err := NewFromDashConfig(config)
+			require.NoError(t, err)
+
+			got, err := l.ForGVK(test.namespace, test.apiVersion, test.kind, "name", "name")
+			require.NoError(t, err)
+			assert.Equal(t, "/path", got.Ref())
+
+			parent := testutil.CreatePod("pod")
+			parent.Namespace = test.namespace
+
+			got, err = l.ForOwner(parent, &metav1.OwnerReference{
+				APIVersion: test.apiVersion,
+				Kind:       test.kind,
+				Name:       "name",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "/path", got.Ref())
+		})
+	}
+}
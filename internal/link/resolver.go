@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package link
+
+import (
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// PathResolver returns the navigation path for an object. It returns an empty path for
+// objects it doesn't route, e.g. a plugin resolver only routes the plugin's own
+// custom resources.
+type PathResolver func(namespace, apiVersion, kind, name string) (string, error)
+
+// resolverLink generates links with paths from a path resolver, and falls back to another
+// link generator for objects the resolver doesn't route.
+type resolverLink struct {
+	fallback Interface
+	resolver PathResolver
+}
+
+var _ Interface = (*resolverLink)(nil)
+
+// NewWithPathResolver creates a link generator which resolves paths with resolver. Objects
+// resolver doesn't route are linked by fallback. If resolver is nil, fallback is returned.
+func NewWithPathResolver(fallback Interface, resolver PathResolver) Interface {
+	if resolver == nil {
+		return fallback
+	}
+
+	return &resolverLink{
+		fallback: fallback,
+		resolver: resolver,
+	}
+}
+
+// ForObject returns a link component referencing an object.
+func (l *resolverLink) ForObject(object runtime.Object, text string) (*component.Link, error) {
+	p, err := l.resolveObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == "" {
+		return l.fallback.ForObject(object, text)
+	}
+
+	return component.NewLink("", text, p), nil
+}
+
+// ForObjectWithQuery returns a link component referencing an object with a query.
+func (l *resolverLink) ForObjectWithQuery(object runtime.Object, text string, query url.Values) (*component.Link, error) {
+	p, err := l.resolveObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == "" {
+		return l.fallback.ForObjectWithQuery(object, text, query)
+	}
+
+	u := url.URL{Path: p}
+	u.RawQuery = query.Encode()
+	return component.NewLink("", text, u.String()), nil
+}
+
+// ForGVK returns a link component referencing an object.
+func (l *resolverLink) ForGVK(namespace, apiVersion, kind, name, text string) (*component.Link, error) {
+	p, err := l.resolve(namespace, apiVersion, kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == "" {
+		return l.fallback.ForGVK(namespace, apiVersion, kind, name, text)
+	}
+
+	return component.NewLink("", text, p), nil
+}
+
+// ForOwner returns a link component for an owner.
+func (l *resolverLink) ForOwner(parent runtime.Object, controllerRef *metav1.OwnerReference) (*component.Link, error) {
+	if controllerRef == nil || parent == nil {
+		return l.fallback.ForOwner(parent, controllerRef)
+	}
+
+	ns, err := meta.NewAccessor().Namespace(parent)
+	if err != nil {
+		return l.fallback.ForOwner(parent, controllerRef)
+	}
+
+	p, err := l.resolve(ns, controllerRef.APIVersion, controllerRef.Kind, controllerRef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == "" {
+		return l.fallback.ForOwner(parent, controllerRef)
+	}
+
+	return component.NewLink("", controllerRef.Name, p), nil
+}
+
+func (l *resolverLink) resolveObject(object runtime.Object) (string, error) {
+	namespace, apiVersion, kind, name, err := objectFields(object)
+	if err != nil {
+		return "", err
+	}
+
+	return l.resolve(namespace, apiVersion, kind, name)
+}
+
+func (l *resolverLink) resolve(namespace, apiVersion, kind, name string) (string, error) {
+	return l.resolver(scopedNamespace(namespace, apiVersion, kind), apiVersion, kind, name)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package link
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// crontabResolver routes crontabs to a plugin's content path.
+func crontabResolver(namespace, apiVersion, kind, name string) (string, error) {
+	if apiVersion != "stable.example.com/v1" || kind != "CronTab" {
+		return "", nil
+	}
+	return "/plugin-name/crontabs/" + namespace + "/" + name, nil
+}
+
+func TestResolverLink_ForGVK(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion string
+		kind       string
+		fallback   bool
+		expected   string
+	}{
+		{
+			name:       "resolved",
+			apiVersion: "stable.example.com/v1",
+			kind:       "CronTab",
+			expected:   "/plugin-name/crontabs/default/name",
+		},
+		{
+			name:       "not resolved",
+			apiVersion: "v1",
+			kind:       "Pod",
+			fallback:   true,
+			expected:   "/fallback",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			fallback := fake.NewMockInterface(controller)
+			if test.fallback {
+				fallback.EXPECT().
+					ForGVK("default", test.apiVersion, test.kind, "name", "text").
+					Return(component.NewLink("", "text", "/fallback"), nil)
+			}
+
+			l := NewWithPathResolver(fallback, crontabResolver)
+
+			got, err := l.ForGVK("default", test.apiVersion, test.kind, "name", "text")
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got.Ref())
+			assert.Equal(t, "text", got.Text())
+		})
+	}
+}
+
+func TestResolverLink_ForObject(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fallback := fake.NewMockInterface(controller)
+
+	deployment := testutil.CreateDeployment("deployment")
+	fallback.EXPECT().
+		ForObject(deployment, "deployment").
+		Return(component.NewLink("", "deployment", "/fallback"), nil)
+
+	l := NewWithPathResolver(fallback, crontabResolver)
+
+	got, err := l.ForObject(testutil.CreateCustomResource("crontab"), "crontab")
+	require.NoError(t, err)
+	assert.Equal(t, "/plugin-name/crontabs/namespace/crontab", got.Ref())
+
+	got, err = l.ForObject(deployment, "deployment")
+	require.NoError(t, err)
+	assert.Equal(t, "/fallback", got.Ref())
+}
+
+func TestResolverLink_ForObjectWithQuery(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	l := NewWithPathResolver(fake.NewMockInterface(controller), crontabResolver)
+
+	query := url.Values{}
+	query.Set("foo", "bar")
+
+	got, err := l.ForObjectWithQuery(testutil.CreateCustomResource("crontab"), "crontab", query)
+	require.NoError(t, err)
+	assert.Equal(t, "/plugin-name/crontabs/namespace/crontab?foo=bar", got.Ref())
+}
+
+func TestResolverLink_ForOwner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	l := NewWithPathResolver(fake.NewMockInterface(controller), crontabResolver)
+
+	got, err := l.ForOwner(testutil.CreatePod("pod"), &metav1.OwnerReference{
+		APIVersion: "stable.example.com/v1",
+		Kind:       "CronTab",
+		Name:       "crontab",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/plugin-name/crontabs/namespace/crontab", got.Ref())
+	assert.Equal(t, "crontab", got.Text())
+}
+
+func TestResolverLink_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	resolver := func(namespace, apiVersion, kind, name string) (string, error) {
+		return "", errors.New("error")
+	}
+
+	l := NewWithPathResolver(fake.NewMockInterface(controller), resolver)
+
+	_, err := l.ForGVK("default", "v1", "Pod", "name", "text")
+	require.Error(t, err)
+}
+
+func TestNewWithPathResolver_nilResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fallback := fake.NewMockInterface(controller)
+
+	require.Equal(t, Interface(fallback), NewWithPathResolver(fallback, nil))
+}
@@ -158,7 +158,7 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 		Printer:  p,
 		LabelSet: opts.LabelSet,
 		Dash:     co.DashConfig,
-		Link:     linkGenerator,
+		Link:     link.NewWithPathResolver(linkGenerator, co.DashConfig.PathResolver()),
 
 		LoadObjects: loaderFactory.LoadObjects,
 		LoadObject:  loaderFactory.LoadObject,
@@ -8,6 +8,7 @@ package clusteroverview
 import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
@@ -76,6 +77,17 @@ var (
 		RootPath:              describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
 	})
 
+	runtimeClassesDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/runtime-classes",
+		ObjectStoreKey: store.Key{APIVersion: "node.k8s.io/v1", Kind: "RuntimeClass"},
+		ListType:       &nodev1beta1.RuntimeClassList{},
+		ObjectType:     &nodev1beta1.RuntimeClass{},
+		Titles:         describer.ResourceTitle{List: "Runtime Classes", Object: "Runtime Class"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewRuntimeClass,
+		RootPath:       describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
+	})
+
 	storagePersistentVolumeDescriber = describer.NewResource(describer.ResourceOptions{
 		Path:           "/storage/persistent-volumes",
 		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "PersistentVolume"},
@@ -157,6 +169,7 @@ var (
 		crdsDescriber,
 		rbacDescriber,
 		nodesDescriber,
+		runtimeClassesDescriber,
 		storageDescriber,
 		portForwardDescriber,
 	)
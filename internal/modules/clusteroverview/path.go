@@ -20,6 +20,7 @@ var (
 		gvk.ClusterRole,
 		gvk.Node,
 		gvk.PersistentVolume,
+		gvk.RuntimeClass,
 		gvk.Namespace,
 		gvk.CustomResourceDefinition,
 		gvk.APIService,
@@ -44,6 +45,8 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/rbac/cluster-role-bindings"
 	case apiVersion == "v1" && kind == "Node":
 		p = "/nodes"
+	case apiVersion == gvk.RuntimeClass.GroupVersion().String() && kind == gvk.RuntimeClass.Kind:
+		p = "/runtime-classes"
 	case apiVersion == "v1" && kind == "PersistentVolume":
 		p = "/storage/persistent-volumes"
 	case apiVersion == "v1" && kind == "Namespace":
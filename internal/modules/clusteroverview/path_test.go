@@ -44,6 +44,13 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "cluster-role-binding",
 			expected:   path.Join("/cluster-overview", "rbac", "cluster-role-bindings", "cluster-role-binding"),
 		},
+		{
+			name:       "RuntimeClass",
+			apiVersion: "node.k8s.io/v1",
+			kind:       "RuntimeClass",
+			objectName: "kata",
+			expected:   path.Join("/cluster-overview", "runtime-classes", "kata"),
+		},
 		{
 			name:       "unknown",
 			apiVersion: "unknown",
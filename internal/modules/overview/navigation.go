@@ -79,6 +79,8 @@ func configAndStorageEntries(ctx context.Context, prefix, namespace string, obje
 
 	neh.Add("Config Maps", "config-maps",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.ConfigMap), objectStore))
+	neh.Add("Leases", "leases",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Lease), objectStore))
 	neh.Add("Persistent Volume Claims", "persistent-volume-claims",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PersistentVolumeClaim), objectStore))
 	neh.Add("Secrets", "secrets",
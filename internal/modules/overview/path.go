@@ -30,6 +30,7 @@ var (
 		gvk.Service,
 		gvk.NetworkPolicy,
 		gvk.ConfigMap,
+		gvk.Lease,
 		gvk.Secret,
 		gvk.PersistentVolumeClaim,
 		gvk.ServiceAccount,
@@ -77,6 +78,8 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/config-and-storage/secrets"
 	case apiVersion == "v1" && kind == "ConfigMap":
 		p = "/config-and-storage/config-maps"
+	case apiVersion == "coordination.k8s.io/v1" && kind == "Lease":
+		p = "/config-and-storage/leases"
 	case apiVersion == "v1" && kind == "PersistentVolumeClaim":
 		p = "/config-and-storage/persistent-volume-claims"
 	case apiVersion == "v1" && kind == "ServiceAccount":
@@ -39,6 +39,14 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "pod",
 			expected:   path.Join("/overview", "namespace", "default", "workloads", "pods", "pod"),
 		},
+		{
+			name:       "lease",
+			namespace:  "default",
+			apiVersion: "coordination.k8s.io/v1",
+			kind:       "Lease",
+			objectName: "lease",
+			expected:   path.Join("/overview", "namespace", "default", "config-and-storage", "leases", "lease"),
+		},
 		{
 			name:       "no namespace",
 			apiVersion: "v1",
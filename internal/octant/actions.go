@@ -19,6 +19,8 @@ const (
 	ActionOverviewCronjob         = "action.octant.dev/cronJob"
 	ActionOverviewSuspendCronjob  = "action.octant.dev/suspendCronJob"
 	ActionOverviewResumeCronjob   = "action.octant.dev/resumeCronJob"
+	ActionOverviewSuspendJob      = "action.octant.dev/suspendJob"
+	ActionOverviewResumeJob       = "action.octant.dev/resumeJob"
 	ActionOverviewServiceEditor   = "action.octant.dev/serviceEditor"
 	ActionDeploymentConfiguration = "action.octant.dev/deploymentConfiguration"
 	ActionUpdateObject            = "action.octant.dev/update"
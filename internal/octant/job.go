@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// JobSuspend sets a job's spec.suspend field. Jobs are updated as unstructured
+// objects, so the field is set even if the batch/v1 types in use predate it.
+type JobSuspend struct {
+	store   store.Store
+	suspend bool
+}
+
+var _ action.Dispatcher = (*JobSuspend)(nil)
+
+// NewJobSuspend creates an instance of JobSuspend which suspends jobs.
+func NewJobSuspend(objectStore store.Store) *JobSuspend {
+	return &JobSuspend{
+		store:   objectStore,
+		suspend: true,
+	}
+}
+
+// NewJobResume creates an instance of JobSuspend which resumes jobs.
+func NewJobResume(objectStore store.Store) *JobSuspend {
+	return &JobSuspend{
+		store:   objectStore,
+		suspend: false,
+	}
+}
+
+// ActionName returns the action name
+func (j *JobSuspend) ActionName() string {
+	if j.suspend {
+		return ActionOverviewSuspendJob
+	}
+	return ActionOverviewResumeJob
+}
+
+// Handle suspending or resuming a job
+func (j *JobSuspend) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", j.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	expiration := time.Now().Add(10 * time.Second)
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	if key.Kind != "Job" {
+		return errors.Errorf("can't suspend %s", key.Kind)
+	}
+
+	verb := "Resuming"
+	if j.suspend {
+		verb = "Suspending"
+	}
+
+	err = j.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
+		if _, found, err := unstructured.NestedBool(u.Object, "spec", "suspend"); err != nil || !found {
+			return fmt.Errorf("job %s does not support suspension", u.GetName())
+		}
+
+		return unstructured.SetNestedField(u.Object, j.suspend, "spec", "suspend")
+	})
+	if err != nil {
+		sendAlert(
+			alerter,
+			action.AlertTypeError,
+			fmt.Sprintf("update: %s", err.Error()),
+			&expiration,
+		)
+		return nil
+	}
+
+	message := fmt.Sprintf("%s %s (%s) %s in %s", verb, key.Kind, key.APIVersion, key.Name, key.Namespace)
+	sendAlert(alerter, action.AlertTypeInfo, message, &expiration)
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func Test_JobSuspend(t *testing.T) {
+	tests := []struct {
+		name              string
+		dispatcher        func(objectStore store.Store) *octant.JobSuspend
+		actionName        string
+		supportsSuspend   bool
+		expectedSuspend   bool
+		expectedAlertType action.AlertType
+	}{
+		{
+			name:              "suspend",
+			dispatcher:        octant.NewJobSuspend,
+			actionName:        octant.ActionOverviewSuspendJob,
+			supportsSuspend:   true,
+			expectedSuspend:   true,
+			expectedAlertType: action.AlertTypeInfo,
+		},
+		{
+			name:              "resume",
+			dispatcher:        octant.NewJobResume,
+			actionName:        octant.ActionOverviewResumeJob,
+			supportsSuspend:   true,
+			expectedSuspend:   false,
+			expectedAlertType: action.AlertTypeInfo,
+		},
+		{
+			name:              "job does not support suspension",
+			dispatcher:        octant.NewJobSuspend,
+			actionName:        octant.ActionOverviewSuspendJob,
+			expectedAlertType: action.AlertTypeError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+
+			objectStore := fake.NewMockStore(controller)
+			alerter := actionFake.NewMockAlerter(controller)
+
+			job := testutil.ToUnstructured(t, testutil.CreateJob("job"))
+			if test.supportsSuspend {
+				require.NoError(t, unstructured.SetNestedField(job.Object, !test.expectedSuspend, "spec", "suspend"))
+			}
+
+			key, err := store.KeyFromObject(job)
+			require.NoError(t, err)
+
+			objectStore.EXPECT().
+				Update(ctx, key, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+					if err := updater(job); err != nil {
+						return err
+					}
+
+					suspend, found, err := unstructured.NestedBool(job.Object, "spec", "suspend")
+					require.NoError(t, err)
+					require.True(t, found)
+					assert.Equal(t, test.expectedSuspend, suspend)
+					return nil
+				})
+
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedAlertType, alert.Type)
+				})
+
+			dispatcher := test.dispatcher(objectStore)
+			assert.Equal(t, test.actionName, dispatcher.ActionName())
+
+			payload := action.CreatePayload(test.actionName, key.ToActionPayload())
+			require.NoError(t, dispatcher.Handle(ctx, alerter, payload))
+		})
+	}
+}
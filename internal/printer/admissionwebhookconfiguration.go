@@ -100,11 +100,24 @@ func admissionWebhookMatchPolicy(matchPolicy *admissionregistrationv1.MatchPolic
 	return component.NewTextf("%s", *matchPolicy)
 }
 
+// admissionWebhookSideEffects prints a webhook's side effect class. Webhooks created
+// through admissionregistration/v1beta1 may still declare the deprecated Unknown or Some
+// classes (Unknown is also the v1beta1 default). Requests with dryRun=true are rejected
+// by those webhooks, so they are flagged with a warning.
 func admissionWebhookSideEffects(sideEffects *admissionregistrationv1.SideEffectClass) component.Component {
-	if sideEffects == nil {
-		return component.NewTextf("%s", admissionregistrationv1.SideEffectClassUnknown)
+	sideEffectClass := admissionregistrationv1.SideEffectClassUnknown
+	if sideEffects != nil {
+		sideEffectClass = *sideEffects
 	}
-	return component.NewTextf("%s", *sideEffects)
+
+	text := component.NewTextf("%s", sideEffectClass)
+	switch sideEffectClass {
+	case admissionregistrationv1.SideEffectClassUnknown, admissionregistrationv1.SideEffectClassSome:
+		text = component.NewTextf("%s (dry run requests will be rejected)", sideEffectClass)
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
 }
 
 func admissionWebhookLabelSelector(selector *metav1.LabelSelector) component.Component {
@@ -122,7 +135,13 @@ func admissionWebhookLabelSelector(selector *metav1.LabelSelector) component.Com
 	return component.NewTextf("%s%s%s", matchLabels, joiner, matchExpressions)
 }
 
+// admissionWebhookAdmissionReviewVersions prints the AdmissionReview versions a webhook
+// accepts. Webhooks created through admissionregistration/v1beta1 may not list any
+// versions, in which case the API server sends v1beta1.
 func admissionWebhookAdmissionReviewVersions(admissionReviewVersions []string) component.Component {
+	if len(admissionReviewVersions) == 0 {
+		return component.NewText("v1beta1")
+	}
 	if len(admissionReviewVersions) == 1 {
 		return component.NewText(admissionReviewVersions[0])
 	}
@@ -6,6 +6,10 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -17,3 +21,84 @@ func testWebhookRulesTable(rows ...component.TableRow) *component.Table {
 	}
 	return table
 }
+
+func Test_admissionWebhookSideEffects(t *testing.T) {
+	sideEffectClass := func(s admissionregistrationv1.SideEffectClass) *admissionregistrationv1.SideEffectClass {
+		return &s
+	}
+	warning := func(s string) *component.Text {
+		return component.NewText(s, func(t *component.Text) {
+			t.SetStatus(component.TextStatusWarning)
+		})
+	}
+
+	tests := []struct {
+		name        string
+		sideEffects *admissionregistrationv1.SideEffectClass
+		expected    component.Component
+	}{
+		{
+			name:        "nil defaults to unknown",
+			sideEffects: nil,
+			expected:    warning("Unknown (dry run requests will be rejected)"),
+		},
+		{
+			name:        "unknown",
+			sideEffects: sideEffectClass(admissionregistrationv1.SideEffectClassUnknown),
+			expected:    warning("Unknown (dry run requests will be rejected)"),
+		},
+		{
+			name:        "some",
+			sideEffects: sideEffectClass(admissionregistrationv1.SideEffectClassSome),
+			expected:    warning("Some (dry run requests will be rejected)"),
+		},
+		{
+			name:        "none",
+			sideEffects: sideEffectClass(admissionregistrationv1.SideEffectClassNone),
+			expected:    component.NewText("None"),
+		},
+		{
+			name:        "none on dry run",
+			sideEffects: sideEffectClass(admissionregistrationv1.SideEffectClassNoneOnDryRun),
+			expected:    component.NewText("NoneOnDryRun"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := admissionWebhookSideEffects(test.sideEffects)
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
+func Test_admissionWebhookAdmissionReviewVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+		expected component.Component
+	}{
+		{
+			name:     "not set",
+			versions: nil,
+			expected: component.NewText("v1beta1"),
+		},
+		{
+			name:     "single version",
+			versions: []string{"v1"},
+			expected: component.NewText("v1"),
+		},
+		{
+			name:     "multiple versions",
+			versions: []string{"v1", "v1beta1"},
+			expected: component.NewMarkdownText("- v1\n- v1beta1\n"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := admissionWebhookAdmissionReviewVersions(test.versions)
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// createPodAdoptionView checks the pods matched by a controller's selector. Pods
+// controlled by a different controller are adoption conflicts, and pods without a
+// controller will be adopted. If every matched pod is controlled by the object,
+// no view is returned.
+func createPodAdoptionView(ctx context.Context, object metav1.Object, selector *metav1.LabelSelector, options Options) (component.Component, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	if selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0) {
+		return nil, nil
+	}
+
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	pods, err := loadPods(ctx, key, options.DashConfig.ObjectStore(), selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "load pods")
+	}
+
+	sections := component.SummarySections{}
+
+	var conflicts, orphans []string
+
+	for _, pod := range pods {
+		controllerRef := metav1.GetControllerOf(pod)
+		switch {
+		case controllerRef == nil:
+			orphans = append(orphans, pod.Name)
+			sections.AddText(pod.Name, "<no controller>")
+		case controllerRef.UID != object.GetUID():
+			conflicts = append(conflicts, pod.Name)
+			ownerLink, err := options.Link.ForOwner(pod, controllerRef)
+			if err != nil {
+				return nil, err
+			}
+			sections.Add(pod.Name, ownerLink)
+		}
+	}
+
+	if len(sections) == 0 {
+		return nil, nil
+	}
+
+	summary := component.NewSummary("Pod Ownership", sections...)
+
+	if len(conflicts) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeError,
+			fmt.Sprintf("Selector matches pods controlled by another controller: %s", strings.Join(conflicts, ", "))))
+	} else {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Selector matches pods without a controller, which will be adopted: %s", strings.Join(orphans, ", "))))
+	}
+
+	return summary, nil
+}
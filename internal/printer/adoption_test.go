@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPodAdoptionView(t *testing.T) {
+	labels := map[string]string{"app": "app"}
+
+	replicaSet := testutil.CreateAppReplicaSet("rs")
+	replicaSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+
+	other := testutil.CreateAppReplicaSet("other")
+
+	newPod := func(name string, owners ...*metav1.OwnerReference) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Labels = labels
+		for _, owner := range owners {
+			pod.OwnerReferences = append(pod.OwnerReferences, *owner)
+		}
+		return pod
+	}
+
+	ownedRef := &testutil.ToOwnerReferences(t, replicaSet)[0]
+	otherRef := &testutil.ToOwnerReferences(t, other)[0]
+
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		pods     []*corev1.Pod
+		expected func() component.Component
+	}{
+		{
+			name:     "all pods are owned",
+			selector: replicaSet.Spec.Selector,
+			pods:     []*corev1.Pod{newPod("pod-1", ownedRef)},
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:     "no selector",
+			selector: &metav1.LabelSelector{},
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:     "pods owned by another controller",
+			selector: replicaSet.Spec.Selector,
+			pods: []*corev1.Pod{
+				newPod("pod-1", ownedRef),
+				newPod("pod-2", otherRef),
+				newPod("pod-3"),
+			},
+			expected: func() component.Component {
+				sections := component.SummarySections{}
+				sections.Add("pod-2", component.NewLink("", "other", "/other"))
+				sections.AddText("pod-3", "<no controller>")
+				summary := component.NewSummary("Pod Ownership", sections...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeError,
+					"Selector matches pods controlled by another controller: pod-2"))
+				return summary
+			},
+		},
+		{
+			name:     "pods without a controller",
+			selector: replicaSet.Spec.Selector,
+			pods:     []*corev1.Pod{newPod("pod-1", ownedRef), newPod("pod-2")},
+			expected: func() component.Component {
+				sections := component.SummarySections{}
+				sections.AddText("pod-2", "<no controller>")
+				summary := component.NewSummary("Pod Ownership", sections...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Selector matches pods without a controller, which will be adopted: pod-2"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			podList := &unstructured.UnstructuredList{}
+			for _, pod := range test.pods {
+				podList.Items = append(podList.Items, *testutil.ToUnstructured(t, pod))
+
+				if ref := metav1.GetControllerOf(pod); ref != nil && ref.UID != replicaSet.UID {
+					tpo.link.EXPECT().ForOwner(gomock.Any(), gomock.Eq(ref)).
+						Return(component.NewLink("", ref.Name, "/"+ref.Name), nil)
+				}
+			}
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+			tpo.objectStore.EXPECT().List(gomock.Any(), key).Return(podList, false, nil).AnyTimes()
+
+			rs := replicaSet.DeepCopy()
+			rs.Spec.Selector = test.selector
+
+			got, err := createPodAdoptionView(context.Background(), rs, rs.Spec.Selector, tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	ageBucketHour  = "Last hour"
+	ageBucketDay   = "Last day"
+	ageBucketWeek  = "Last week"
+	ageBucketOlder = "Older"
+
+	// ageBucketColumn is the list column objects are grouped into age buckets by.
+	ageBucketColumn = "Age Bucket"
+)
+
+var (
+	// ageBuckets are the age buckets, from newest to oldest.
+	ageBuckets = []string{ageBucketHour, ageBucketDay, ageBucketWeek, ageBucketOlder}
+)
+
+// ageFilter excludes objects outside of an age window from lists, and optionally groups
+// listed objects into age buckets.
+type ageFilter struct {
+	minAge  time.Duration
+	maxAge  time.Duration
+	buckets bool
+	clock   clock.Clock
+}
+
+// newAgeFilter creates an age filter from options. Ages are measured with options.Clock, or
+// the real clock if it is not set.
+func newAgeFilter(options Options) ageFilter {
+	c := options.Clock
+	if c == nil {
+		c = clock.RealClock{}
+	}
+
+	return ageFilter{
+		minAge:  options.MinAge,
+		maxAge:  options.MaxAge,
+		buckets: options.AgeBuckets,
+		clock:   c,
+	}
+}
+
+// includes returns true if an object created at creationTimestamp is within the age window.
+// Without a minimum or maximum age, every object is included.
+func (f ageFilter) includes(creationTimestamp time.Time) bool {
+	age := f.clock.Since(creationTimestamp)
+
+	if f.minAge > 0 && age < f.minAge {
+		return false
+	}
+
+	if f.maxAge > 0 && age > f.maxAge {
+		return false
+	}
+
+	return true
+}
+
+// bucket returns the age bucket for an object created at creationTimestamp.
+func (f ageFilter) bucket(creationTimestamp time.Time) string {
+	age := f.clock.Since(creationTimestamp)
+
+	switch {
+	case age <= time.Hour:
+		return ageBucketHour
+	case age <= 24*time.Hour:
+		return ageBucketDay
+	case age <= 7*24*time.Hour:
+		return ageBucketWeek
+	default:
+		return ageBucketOlder
+	}
+}
+
+// filterRow returns false if an object created at creationTimestamp is outside of the age
+// window. Otherwise, the object's age bucket is added to row if buckets are enabled.
+func (f ageFilter) filterRow(creationTimestamp time.Time, row component.TableRow) bool {
+	if f.clock == nil {
+		return true
+	}
+
+	if !f.includes(creationTimestamp) {
+		return false
+	}
+
+	if f.buckets {
+		row[ageBucketColumn] = component.NewText(f.bucket(creationTimestamp))
+	}
+
+	return true
+}
+
+// addBucketFilter adds the age bucket column and its filter to a table if buckets are enabled.
+func (f ageFilter) addBucketFilter(table *component.Table) {
+	if !f.buckets {
+		return
+	}
+
+	table.AddColumn(ageBucketColumn)
+	table.AddFilter(ageBucketColumn, component.TableFilter{
+		Values:   ageBuckets,
+		Selected: []string{},
+	})
+}
@@ -25,6 +25,7 @@ func APIServiceListHandler(ctx context.Context, list *apiregistrationv1.APIServi
 
 	cols := component.NewTableCols("Name", "Service", "Age")
 	ot := NewObjectTable("API Services", "We couldn't find any api services!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, apiService := range list.Items {
 		row := component.TableRow{}
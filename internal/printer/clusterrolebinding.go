@@ -23,6 +23,7 @@ func ClusterRoleBindingListHandler(ctx context.Context, clusterRoleBindingList *
 
 	columns := component.NewTableCols("Name", "Labels", "Age", "Role kind", "Role name")
 	ot := NewObjectTable("Cluster Role Bindings", "We couldn't find any cluster role bindings!", columns, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, roleBinding := range clusterRoleBindingList.Items {
 		row := component.TableRow{}
@@ -34,7 +35,7 @@ func ClusterRoleBindingListHandler(ctx context.Context, clusterRoleBindingList *
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(roleBinding.Labels)
+		row["Labels"] = createLabelsView(&roleBinding, roleBinding.Labels, options)
 		row["Age"] = component.NewTimestamp(roleBinding.CreationTimestamp.Time)
 		row["Role kind"] = component.NewText(roleBinding.RoleRef.Kind)
 
@@ -0,0 +1,369 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// compareField is a field which is compared between two objects.
+type compareField struct {
+	name string
+	a    string
+	b    string
+}
+
+func (f compareField) differs() bool {
+	return f.a != f.b
+}
+
+// CompareHandler prints two objects of the same kind side by side. Each object's summary
+// is printed in its own column, and fields which differ between the objects are highlighted
+// and listed in a differences table. Fields compared are labels and, for objects with a pod
+// spec, the node, service account, and each container's image, resources, and environment.
+func CompareHandler(a, b runtime.Object, options Options) (component.Component, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("can't compare a nil object")
+	}
+
+	gvkA, err := objectGroupVersionKind(a)
+	if err != nil {
+		return nil, err
+	}
+
+	gvkB, err := objectGroupVersionKind(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if gvkA != gvkB {
+		return nil, errors.Errorf("can't compare %s with %s: objects must be the same kind",
+			describeGroupVersionKind(gvkA), describeGroupVersionKind(gvkB))
+	}
+
+	fields, err := compareFields(a, b, gvkA)
+	if err != nil {
+		return nil, err
+	}
+
+	titleA, titleB, err := compareTitles(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := component.NewTableCols("Field", titleA, titleB)
+	differences := component.NewTable("Differences", "Objects have no differences", cols)
+
+	var sectionsA, sectionsB component.SummarySections
+	for _, field := range fields {
+		textA := component.NewText(field.a)
+		textB := component.NewText(field.b)
+
+		if field.differs() {
+			textA.SetStatus(component.TextStatusWarning)
+			textB.SetStatus(component.TextStatusWarning)
+
+			differences.Add(component.TableRow{
+				"Field": component.NewText(field.name),
+				titleA:  component.NewText(field.a),
+				titleB:  component.NewText(field.b),
+			})
+		}
+
+		sectionsA.Add(field.name, textA)
+		sectionsB.Add(field.name, textB)
+	}
+
+	fl := component.NewFlexLayout(fmt.Sprintf("Compare %s", gvkA.Kind))
+	fl.AddSections(
+		component.FlexLayoutSection{
+			{Width: component.WidthHalf, View: component.NewSummary(titleA, sectionsA...)},
+			{Width: component.WidthHalf, View: component.NewSummary(titleB, sectionsB...)},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: differences},
+		},
+	)
+
+	return fl, nil
+}
+
+// objectGroupVersionKind returns an object's group/version/kind. Typed objects without type
+// metadata are looked up in the scheme.
+func objectGroupVersionKind(object runtime.Object) (schema.GroupVersionKind, error) {
+	groupVersionKind := object.GetObjectKind().GroupVersionKind()
+	if !groupVersionKind.Empty() {
+		return groupVersionKind, nil
+	}
+
+	kinds, _, err := scheme.Scheme.ObjectKinds(object)
+	if err != nil {
+		return schema.GroupVersionKind{}, errors.Wrap(err, "find object kind")
+	}
+
+	return kinds[0], nil
+}
+
+func describeGroupVersionKind(groupVersionKind schema.GroupVersionKind) string {
+	apiVersion, kind := groupVersionKind.ToAPIVersionAndKind()
+	return fmt.Sprintf("%s %s", apiVersion, kind)
+}
+
+// compareTitles returns column titles for two objects. Objects are titled by name, and
+// by namespace and name if their names are the same.
+func compareTitles(a, b runtime.Object) (string, string, error) {
+	accessorA, err := meta.Accessor(a)
+	if err != nil {
+		return "", "", err
+	}
+
+	accessorB, err := meta.Accessor(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	titleA, titleB := accessorA.GetName(), accessorB.GetName()
+	if titleA == titleB {
+		titleA = fmt.Sprintf("%s/%s", accessorA.GetNamespace(), accessorA.GetName())
+		titleB = fmt.Sprintf("%s/%s", accessorB.GetNamespace(), accessorB.GetName())
+	}
+
+	if titleA == titleB {
+		titleA, titleB = titleA+" (a)", titleB+" (b)"
+	}
+
+	return titleA, titleB, nil
+}
+
+// compareFields returns the fields compared between two objects.
+func compareFields(a, b runtime.Object, groupVersionKind schema.GroupVersionKind) ([]compareField, error) {
+	accessorA, err := meta.Accessor(a)
+	if err != nil {
+		return nil, err
+	}
+
+	accessorB, err := meta.Accessor(b)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []compareField{
+		{name: "Namespace", a: accessorA.GetNamespace(), b: accessorB.GetNamespace()},
+		{name: "Labels", a: compareLabels(accessorA.GetLabels()), b: compareLabels(accessorB.GetLabels())},
+	}
+
+	specA, err := comparePodSpec(a, groupVersionKind)
+	if err != nil {
+		return nil, err
+	}
+
+	specB, err := comparePodSpec(b, groupVersionKind)
+	if err != nil {
+		return nil, err
+	}
+
+	if specA == nil || specB == nil {
+		return fields, nil
+	}
+
+	if groupVersionKind.Kind == "Pod" {
+		fields = append(fields, compareField{
+			name: "Node",
+			a:    valueOrNone(specA.NodeName),
+			b:    valueOrNone(specB.NodeName),
+		})
+	}
+
+	fields = append(fields, compareField{
+		name: "Service Account",
+		a:    valueOrNone(specA.ServiceAccountName),
+		b:    valueOrNone(specB.ServiceAccountName),
+	})
+
+	containersA := compareContainersByName(specA)
+	containersB := compareContainersByName(specB)
+
+	for _, name := range compareContainerNames(specA, specB) {
+		containerA, okA := containersA[name]
+		containerB, okB := containersB[name]
+
+		describe := func(c corev1.Container, ok bool, fn func(corev1.Container) string) string {
+			if !ok {
+				return "<no container>"
+			}
+			return fn(c)
+		}
+
+		fields = append(fields,
+			compareField{
+				name: fmt.Sprintf("%s Image", name),
+				a:    describe(containerA, okA, func(c corev1.Container) string { return c.Image }),
+				b:    describe(containerB, okB, func(c corev1.Container) string { return c.Image }),
+			},
+			compareField{
+				name: fmt.Sprintf("%s Requests", name),
+				a:    describe(containerA, okA, func(c corev1.Container) string { return formatResourceList(c.Resources.Requests) }),
+				b:    describe(containerB, okB, func(c corev1.Container) string { return formatResourceList(c.Resources.Requests) }),
+			},
+			compareField{
+				name: fmt.Sprintf("%s Limits", name),
+				a:    describe(containerA, okA, func(c corev1.Container) string { return formatResourceList(c.Resources.Limits) }),
+				b:    describe(containerB, okB, func(c corev1.Container) string { return formatResourceList(c.Resources.Limits) }),
+			},
+			compareField{
+				name: fmt.Sprintf("%s Env", name),
+				a:    describe(containerA, okA, compareEnv),
+				b:    describe(containerB, okB, compareEnv),
+			},
+		)
+	}
+
+	return fields, nil
+}
+
+// comparePodSpec returns the pod spec for a pod, or the pod template spec for a workload or
+// a controller revision. Objects without a pod spec return nil.
+func comparePodSpec(object runtime.Object, groupVersionKind schema.GroupVersionKind) (*corev1.PodSpec, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert object to unstructured")
+	}
+
+	fields := []string{"spec", "template", "spec"}
+	switch groupVersionKind.Kind {
+	case "Pod":
+		fields = []string{"spec"}
+	case "ControllerRevision":
+		fields = []string{"data", "spec", "template", "spec"}
+	}
+
+	specMap, found, err := unstructured.NestedMap(m, fields...)
+	if err != nil || !found {
+		return nil, nil
+	}
+
+	if _, ok := specMap["containers"]; !ok {
+		return nil, nil
+	}
+
+	spec := &corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, spec); err != nil {
+		return nil, errors.Wrap(err, "convert pod spec")
+	}
+
+	return spec, nil
+}
+
+func compareContainersByName(spec *corev1.PodSpec) map[string]corev1.Container {
+	containers := map[string]corev1.Container{}
+	for _, c := range spec.Containers {
+		containers[c.Name] = c
+	}
+	return containers
+}
+
+// compareContainerNames returns the names of containers in both specs, in the order they
+// appear.
+func compareContainerNames(a, b *corev1.PodSpec) []string {
+	var names []string
+	seen := map[string]bool{}
+
+	for _, spec := range []*corev1.PodSpec{a, b} {
+		for _, c := range spec.Containers {
+			if seen[c.Name] {
+				continue
+			}
+			seen[c.Name] = true
+			names = append(names, c.Name)
+		}
+	}
+
+	return names
+}
+
+func compareLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "<none>"
+	}
+
+	var list []string
+	for k, v := range labels {
+		list = append(list, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(list)
+
+	return strings.Join(list, ", ")
+}
+
+// formatResourceList prints a resource list as name=quantity pairs sorted by name.
+func formatResourceList(list corev1.ResourceList) string {
+	if len(list) == 0 {
+		return "<none>"
+	}
+
+	var names []string
+	for name := range list {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	var out []string
+	for _, name := range names {
+		quantity := list[corev1.ResourceName(name)]
+		out = append(out, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+
+	return strings.Join(out, ", ")
+}
+
+// compareEnv describes a container's environment. Values from references are described
+// by their source so they can be compared without being resolved.
+func compareEnv(c corev1.Container) string {
+	if len(c.Env) == 0 {
+		return "<none>"
+	}
+
+	var out []string
+	for _, env := range c.Env {
+		value := env.Value
+
+		if from := env.ValueFrom; from != nil {
+			switch {
+			case from.ConfigMapKeyRef != nil:
+				value = fmt.Sprintf("<configmap %s:%s>", from.ConfigMapKeyRef.Name, from.ConfigMapKeyRef.Key)
+			case from.SecretKeyRef != nil:
+				value = fmt.Sprintf("<secret %s:%s>", from.SecretKeyRef.Name, from.SecretKeyRef.Key)
+			case from.FieldRef != nil:
+				value = fmt.Sprintf("<field %s>", from.FieldRef.FieldPath)
+			case from.ResourceFieldRef != nil:
+				value = fmt.Sprintf("<resource %s>", from.ResourceFieldRef.Resource)
+			}
+		}
+
+		out = append(out, fmt.Sprintf("%s=%s", env.Name, value))
+	}
+
+	return strings.Join(out, ", ")
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestCompareHandler(t *testing.T) {
+	podA := testutil.CreatePod("pod-a")
+	podA.Labels = map[string]string{"app": "web"}
+	podA.Spec.NodeName = "node-1"
+	podA.Spec.Containers = []corev1.Container{
+		{
+			Name:  "web",
+			Image: "nginx:1.19",
+			Env:   []corev1.EnvVar{{Name: "MODE", Value: "prod"}},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+		},
+	}
+
+	podB := podA.DeepCopy()
+	podB.Name = "pod-b"
+	podB.Spec.NodeName = "node-2"
+	podB.Spec.Containers[0].Image = "nginx:1.18"
+	podB.Spec.Containers = append(podB.Spec.Containers, corev1.Container{
+		Name:  "sidecar",
+		Image: "envoy",
+		Env: []corev1.EnvVar{
+			{
+				Name: "TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "token"},
+						Key:                  "value",
+					},
+				},
+			},
+		},
+	})
+
+	warning := func(s string) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+
+	tests := []struct {
+		name     string
+		a        runtime.Object
+		b        runtime.Object
+		expected func() component.Component
+		isErr    bool
+	}{
+		{
+			name:  "nil object",
+			a:     podA,
+			isErr: true,
+		},
+		{
+			name:  "different kinds",
+			a:     podA,
+			b:     testutil.CreateDeployment("deployment"),
+			isErr: true,
+		},
+		{
+			name: "pods",
+			a:    podA,
+			b:    podB,
+			expected: func() component.Component {
+				differences := component.NewTable("Differences", "Objects have no differences",
+					component.NewTableCols("Field", "pod-a", "pod-b"))
+				differences.Add(
+					component.TableRow{
+						"Field": component.NewText("Node"),
+						"pod-a": component.NewText("node-1"),
+						"pod-b": component.NewText("node-2"),
+					},
+					component.TableRow{
+						"Field": component.NewText("web Image"),
+						"pod-a": component.NewText("nginx:1.19"),
+						"pod-b": component.NewText("nginx:1.18"),
+					},
+					component.TableRow{
+						"Field": component.NewText("sidecar Image"),
+						"pod-a": component.NewText("<no container>"),
+						"pod-b": component.NewText("envoy"),
+					},
+					component.TableRow{
+						"Field": component.NewText("sidecar Requests"),
+						"pod-a": component.NewText("<no container>"),
+						"pod-b": component.NewText("<none>"),
+					},
+					component.TableRow{
+						"Field": component.NewText("sidecar Limits"),
+						"pod-a": component.NewText("<no container>"),
+						"pod-b": component.NewText("<none>"),
+					},
+					component.TableRow{
+						"Field": component.NewText("sidecar Env"),
+						"pod-a": component.NewText("<no container>"),
+						"pod-b": component.NewText("TOKEN=<secret token:value>"),
+					},
+				)
+
+				summaryA := component.NewSummary("pod-a", component.SummarySections{
+					{Header: "Namespace", Content: component.NewText("namespace")},
+					{Header: "Labels", Content: component.NewText("app=web")},
+					{Header: "Node", Content: warning("node-1")},
+					{Header: "Service Account", Content: component.NewText("<none>")},
+					{Header: "web Image", Content: warning("nginx:1.19")},
+					{Header: "web Requests", Content: component.NewText("cpu=100m")},
+					{Header: "web Limits", Content: component.NewText("<none>")},
+					{Header: "web Env", Content: component.NewText("MODE=prod")},
+					{Header: "sidecar Image", Content: warning("<no container>")},
+					{Header: "sidecar Requests", Content: warning("<no container>")},
+					{Header: "sidecar Limits", Content: warning("<no container>")},
+					{Header: "sidecar Env", Content: warning("<no container>")},
+				}...)
+
+				summaryB := component.NewSummary("pod-b", component.SummarySections{
+					{Header: "Namespace", Content: component.NewText("namespace")},
+					{Header: "Labels", Content: component.NewText("app=web")},
+					{Header: "Node", Content: warning("node-2")},
+					{Header: "Service Account", Content: component.NewText("<none>")},
+					{Header: "web Image", Content: warning("nginx:1.18")},
+					{Header: "web Requests", Content: component.NewText("cpu=100m")},
+					{Header: "web Limits", Content: component.NewText("<none>")},
+					{Header: "web Env", Content: component.NewText("MODE=prod")},
+					{Header: "sidecar Image", Content: warning("envoy")},
+					{Header: "sidecar Requests", Content: warning("<none>")},
+					{Header: "sidecar Limits", Content: warning("<none>")},
+					{Header: "sidecar Env", Content: warning("TOKEN=<secret token:value>")},
+				}...)
+
+				fl := component.NewFlexLayout("Compare Pod")
+				fl.AddSections(
+					component.FlexLayoutSection{
+						{Width: component.WidthHalf, View: summaryA},
+						{Width: component.WidthHalf, View: summaryB},
+					},
+					component.FlexLayoutSection{
+						{Width: component.WidthFull, View: differences},
+					},
+				)
+				return fl
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			got, err := CompareHandler(test.a, test.b, tpo.ToOptions())
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+func Test_compareTitles(t *testing.T) {
+	a := testutil.CreatePod("pod")
+	b := testutil.CreatePod("pod")
+	b.Namespace = "other"
+
+	titleA, titleB, err := compareTitles(a, b)
+	require.NoError(t, err)
+	require.Equal(t, "namespace/pod", titleA)
+	require.Equal(t, "other/pod", titleB)
+}
+
+func Test_comparePodSpec_controllerRevision(t *testing.T) {
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: "revision", Namespace: "namespace"},
+		Data: runtime.RawExtension{
+			Raw: []byte(`{"spec": {"template": {"spec": {"containers": [{"name": "app", "image": "nginx"}]}}}}`),
+		},
+	}
+
+	spec, err := comparePodSpec(revision, appsv1.SchemeGroupVersion.WithKind("ControllerRevision"))
+	require.NoError(t, err)
+	require.Equal(t, &corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx"}}}, spec)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// configChecksumAnnotationPrefix is the prefix of pod template annotations which record
+	// the version of the configuration a workload was rolled out with.
+	configChecksumAnnotationPrefix = "checksum/"
+)
+
+var (
+	configDriftCols = component.NewTableCols("Kind", "Name", "Resource Version", "Status")
+)
+
+// configReference is a ConfigMap or Secret referenced by a pod template.
+type configReference struct {
+	kind string
+	name string
+}
+
+// createConfigDriftView prints the ConfigMaps and Secrets referenced by a workload's pod
+// template and whether the workload was rolled out with their current contents. A reference
+// is current when a checksum annotation on the pod template matches either its resource
+// version or the SHA-256 of its data. Without checksum annotations, drift can't be
+// determined. Pod templates without references have no view.
+func createConfigDriftView(ctx context.Context, namespace string, template corev1.PodTemplateSpec, options Options) (component.Component, error) {
+	references := podTemplateConfigReferences(template.Spec)
+	if len(references) == 0 {
+		return nil, nil
+	}
+
+	checksums := map[string]bool{}
+	for k, v := range template.Annotations {
+		if strings.HasPrefix(k, configChecksumAnnotationPrefix) {
+			checksums[v] = true
+		}
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	table := component.NewTable("References", "Pod template has no configuration references", configDriftCols)
+
+	var stale []string
+	for _, ref := range references {
+		key := store.Key{
+			Namespace:  namespace,
+			APIVersion: "v1",
+			Kind:       ref.kind,
+			Name:       ref.name,
+		}
+
+		object, err := objectStore.Get(ctx, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get %s %s", ref.kind, ref.name)
+		}
+
+		nameLink, err := options.Link.ForGVK(namespace, "v1", ref.kind, ref.name, ref.name)
+		if err != nil {
+			return nil, err
+		}
+
+		row := component.TableRow{
+			"Kind": component.NewText(ref.kind),
+			"Name": nameLink,
+		}
+
+		var status *component.Text
+		switch {
+		case object == nil:
+			row["Resource Version"] = component.NewText("<unknown>")
+			status = component.NewText("Not found")
+			status.SetStatus(component.TextStatusError)
+		case len(checksums) == 0:
+			row["Resource Version"] = component.NewText(object.GetResourceVersion())
+			status = component.NewText("Unknown")
+		default:
+			row["Resource Version"] = component.NewText(object.GetResourceVersion())
+
+			hash, err := configDataHash(object)
+			if err != nil {
+				return nil, err
+			}
+
+			if checksums[object.GetResourceVersion()] || checksums[hash] {
+				status = component.NewText("Current")
+				status.SetStatus(component.TextStatusOK)
+			} else {
+				status = component.NewText("Stale")
+				status.SetStatus(component.TextStatusWarning)
+				stale = append(stale, ref.name)
+			}
+		}
+		row["Status"] = status
+
+		table.Add(row)
+	}
+
+	var sections component.SummarySections
+
+	switch {
+	case len(checksums) == 0:
+		sections.AddText("Status", "Drift can't be determined: pod template has no checksum annotation")
+	case len(stale) > 0:
+		status := component.NewText("May be running stale configuration")
+		status.SetStatus(component.TextStatusWarning)
+		sections.Add("Status", status)
+	default:
+		status := component.NewText("Current")
+		status.SetStatus(component.TextStatusOK)
+		sections.Add("Status", status)
+	}
+
+	sections.Add("References", table)
+
+	summary := component.NewSummary("Configuration Drift", sections...)
+	if len(stale) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			"Configuration changed since the workload was rolled out: "+strings.Join(stale, ", ")+
+				". Pods may be running stale configuration until the workload is restarted."))
+	}
+
+	return summary, nil
+}
+
+// podTemplateConfigReferences returns the ConfigMaps and Secrets referenced by a pod spec's
+// volumes and container environments, sorted by kind and name.
+func podTemplateConfigReferences(spec corev1.PodSpec) []configReference {
+	seen := map[configReference]bool{}
+	add := func(kind, name string) {
+		if name != "" {
+			seen[configReference{kind: kind, name: name}] = true
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if cm := volume.ConfigMap; cm != nil {
+			add("ConfigMap", cm.Name)
+		}
+		if secret := volume.Secret; secret != nil {
+			add("Secret", secret.SecretName)
+		}
+		if projected := volume.Projected; projected != nil {
+			for _, source := range projected.Sources {
+				if source.ConfigMap != nil {
+					add("ConfigMap", source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					add("Secret", source.Secret.Name)
+				}
+			}
+		}
+	}
+
+	containers := append([]corev1.Container{}, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+
+	for _, c := range containers {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add("ConfigMap", envFrom.ConfigMapRef.Name)
+			}
+			if envFrom.SecretRef != nil {
+				add("Secret", envFrom.SecretRef.Name)
+			}
+		}
+
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+				add("ConfigMap", ref.Name)
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+				add("Secret", ref.Name)
+			}
+		}
+	}
+
+	var references []configReference
+	for ref := range seen {
+		references = append(references, ref)
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].kind != references[j].kind {
+			return references[i].kind < references[j].kind
+		}
+		return references[i].name < references[j].name
+	})
+
+	return references
+}
+
+// configDataHash returns the hex encoded SHA-256 of a ConfigMap or Secret's data.
+func configDataHash(object *unstructured.Unstructured) (string, error) {
+	data := map[string]interface{}{}
+	for _, field := range []string{"data", "binaryData"} {
+		if value, ok := object.Object[field]; ok {
+			data[field] = value
+		}
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal configuration data")
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createConfigDriftView(t *testing.T) {
+	configMap := testutil.CreateConfigMap("config")
+	configMap.ResourceVersion = "12"
+	configMap.Data = map[string]string{"key": "value"}
+
+	configMapHash, err := configDataHash(testutil.ToUnstructured(t, configMap))
+	require.NoError(t, err)
+
+	statusText := func(value string, status component.TextStatus) *component.Text {
+		text := component.NewText(value)
+		text.SetStatus(status)
+		return text
+	}
+
+	referencesTable := func(rows ...component.TableRow) *component.Table {
+		table := component.NewTable("References", "Pod template has no configuration references", configDriftCols)
+		table.Add(rows...)
+		return table
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		notFound    bool
+		expected    func() component.Component
+	}{
+		{
+			name: "no checksum annotation",
+			expected: func() component.Component {
+				return component.NewSummary("Configuration Drift", component.SummarySections{
+					{Header: "Status", Content: component.NewText("Drift can't be determined: pod template has no checksum annotation")},
+					{Header: "References", Content: referencesTable(component.TableRow{
+						"Kind":             component.NewText("ConfigMap"),
+						"Name":             component.NewLink("", "config", "/config"),
+						"Resource Version": component.NewText("12"),
+						"Status":           component.NewText("Unknown"),
+					})},
+				}...)
+			},
+		},
+		{
+			name:        "checksum matches data hash",
+			annotations: map[string]string{"checksum/config": configMapHash},
+			expected: func() component.Component {
+				return component.NewSummary("Configuration Drift", component.SummarySections{
+					{Header: "Status", Content: statusText("Current", component.TextStatusOK)},
+					{Header: "References", Content: referencesTable(component.TableRow{
+						"Kind":             component.NewText("ConfigMap"),
+						"Name":             component.NewLink("", "config", "/config"),
+						"Resource Version": component.NewText("12"),
+						"Status":           statusText("Current", component.TextStatusOK),
+					})},
+				}...)
+			},
+		},
+		{
+			name:        "checksum is stale",
+			annotations: map[string]string{"checksum/config": "11"},
+			expected: func() component.Component {
+				summary := component.NewSummary("Configuration Drift", component.SummarySections{
+					{Header: "Status", Content: statusText("May be running stale configuration", component.TextStatusWarning)},
+					{Header: "References", Content: referencesTable(component.TableRow{
+						"Kind":             component.NewText("ConfigMap"),
+						"Name":             component.NewLink("", "config", "/config"),
+						"Resource Version": component.NewText("12"),
+						"Status":           statusText("Stale", component.TextStatusWarning),
+					})},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Configuration changed since the workload was rolled out: config. Pods may be running stale configuration until the workload is restarted."))
+				return summary
+			},
+		},
+		{
+			name:        "reference not found",
+			annotations: map[string]string{"checksum/config": "12"},
+			notFound:    true,
+			expected: func() component.Component {
+				return component.NewSummary("Configuration Drift", component.SummarySections{
+					{Header: "Status", Content: statusText("Current", component.TextStatusOK)},
+					{Header: "References", Content: referencesTable(component.TableRow{
+						"Kind":             component.NewText("ConfigMap"),
+						"Name":             component.NewLink("", "config", "/config"),
+						"Resource Version": component.NewText("<unknown>"),
+						"Status":           statusText("Not found", component.TextStatusError),
+					})},
+				}...)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			var stored *unstructured.Unstructured
+			if !test.notFound {
+				stored = testutil.ToUnstructured(t, configMap)
+			}
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "config"}
+			tpo.objectStore.EXPECT().Get(ctx, key).Return(stored, nil)
+			tpo.PathForGVK("namespace", "v1", "ConfigMap", "config", "config", "/config")
+
+			template := corev1.PodTemplateSpec{}
+			template.Annotations = test.annotations
+			template.Spec.Containers = []corev1.Container{
+				{
+					Name: "app",
+					EnvFrom: []corev1.EnvFromSource{
+						{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}},
+					},
+				},
+			}
+
+			got, err := createConfigDriftView(ctx, "namespace", template, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+func Test_podTemplateConfigReferences(t *testing.T) {
+	spec := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "secret",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "tls"},
+				},
+			},
+			{
+				Name: "projected",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}}},
+						},
+					},
+				},
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name: "app",
+				Env: []corev1.EnvVar{
+					{
+						Name: "PASSWORD",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "tls"},
+								Key:                  "password",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := podTemplateConfigReferences(spec)
+
+	expected := []configReference{
+		{kind: "ConfigMap", name: "settings"},
+		{kind: "Secret", name: "tls"},
+	}
+	require.Equal(t, expected, got)
+
+	require.Nil(t, podTemplateConfigReferences(corev1.PodSpec{}))
+}
@@ -27,6 +27,7 @@ func ConfigMapListHandler(ctx context.Context, list *corev1.ConfigMapList, opts
 	// Data column
 	cols := component.NewTableCols("Name", "Labels", "Data", "Age")
 	ot := NewObjectTable("ConfigMaps", "We couldn't find any config maps!", cols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
 
 	for _, c := range list.Items {
 		row := component.TableRow{}
@@ -38,7 +39,7 @@ func ConfigMapListHandler(ctx context.Context, list *corev1.ConfigMapList, opts
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(c.Labels)
+		row["Labels"] = createLabelsView(&c, c.Labels, opts)
 
 		data := fmt.Sprintf("%d", len(c.Data))
 		row["Data"] = component.NewText(data)
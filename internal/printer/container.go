@@ -25,6 +25,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -137,6 +138,20 @@ func (cc *ContainerConfiguration) Create() (*component.Summary, error) {
 		sections.AddText("Restart Count", fmt.Sprintf("%d", containerStatus.RestartCount))
 	}
 
+	if containerStatus != nil {
+		if message, found := describeContainerTerminationMessage(c, containerStatus.LastTerminationState); found {
+			sections.Add("Last Termination Message", message)
+		}
+	}
+
+	if pod, ok := cc.parent.(*corev1.Pod); ok {
+		resizeSections, err := containerResizeSections(cc.context, pod, c.Name, cc.isInit, cc.options)
+		if err != nil {
+			return nil, errors.Wrap(err, "describe container resize")
+		}
+		sections = append(sections, resizeSections...)
+	}
+
 	envTbl, err := describeContainerEnv(cc.context, cc.parent, c, cc.options)
 	if err != nil {
 		return nil, errors.Wrap(err, "describing environment")
@@ -155,6 +170,10 @@ func (cc *ContainerConfiguration) Create() (*component.Summary, error) {
 		sections.AddText("Args", args)
 	}
 
+	sections.Add("Interactive", describeContainerInteractive(c))
+
+	sections = append(sections, describeContainerProbes(c)...)
+
 	if len(c.VolumeMounts) > 0 {
 		sections.Add("Volume Mounts", describeVolumeMounts(c))
 	}
@@ -195,6 +214,56 @@ func printContainerState(state corev1.ContainerState) (string, bool) {
 	return "indeterminate", false
 }
 
+// describeContainerTerminationMessage prints the message a container wrote when it last
+// terminated. Messages from containers which exited with a non-zero code are printed as
+// errors. Containers with the FallbackToLogsOnError policy note that the message may be the
+// end of the container's log.
+func describeContainerTerminationMessage(c *corev1.Container, state corev1.ContainerState) (*component.Text, bool) {
+	terminated := state.Terminated
+	if terminated == nil {
+		return nil, false
+	}
+
+	message := strings.TrimSpace(terminated.Message)
+	if message == "" {
+		message = "—"
+	}
+
+	if c.TerminationMessagePolicy == corev1.TerminationMessageFallbackToLogsOnError {
+		message = fmt.Sprintf("%s (%s: falls back to the end of the log on error)",
+			message, corev1.TerminationMessageFallbackToLogsOnError)
+	}
+
+	text := component.NewText(message)
+	if terminated.ExitCode != 0 {
+		text.SetStatus(component.TextStatusError)
+	}
+
+	return text, true
+}
+
+// describeContainerInteractive prints whether a user can attach to a container interactively.
+// Attaching needs stdin, and a TTY for terminal programs.
+func describeContainerInteractive(c *corev1.Container) *component.Text {
+	var text string
+	switch {
+	case c.Stdin && c.TTY:
+		text = "Attachable with TTY"
+	case c.Stdin:
+		text = "Attachable without TTY"
+	case c.TTY:
+		text = "Not attachable: TTY without stdin"
+	default:
+		text = "Not attachable: attach will have no stdin or TTY"
+	}
+
+	if c.Stdin && c.StdinOnce {
+		text += ", stdin closes after the first attach"
+	}
+
+	return component.NewText(text)
+}
+
 type containerStatus interface {
 	isContainerFound() bool
 }
@@ -345,7 +414,7 @@ func describeContainerEnv(ctx context.Context, parent runtime.Object, c *corev1.
 		return nil, err
 	}
 	tbl.Add(envRows...)
-	envFromRows, err := describeEnvFromRows(ns, c.EnvFrom, options)
+	envFromRows, err := describeEnvFromRows(ctx, ns, c.EnvFrom, options)
 	if err != nil {
 		return nil, err
 	}
@@ -382,33 +451,20 @@ func describeEnvRows(ctx context.Context, namespace string, vars []corev1.EnvVar
 			row["Source"] = component.NewText(ref.Resource)
 		case e.ValueFrom.SecretKeyRef != nil:
 			ref := e.ValueFrom.SecretKeyRef
-			source, err := options.Link.ForGVK(namespace, "v1", "Secret", ref.Name,
-				fmt.Sprintf("%s:%s", ref.Name, ref.Key))
+			_, source, err := describeConfigReference(ctx, namespace, "Secret", ref.Name,
+				fmt.Sprintf("%s:%s", ref.Name, ref.Key), ref.Optional, options)
 			if err != nil {
 				return nil, err
 			}
 			row["Source"] = source
 		case e.ValueFrom.ConfigMapKeyRef != nil:
 			ref := e.ValueFrom.ConfigMapKeyRef
-			source, err := options.Link.ForGVK(namespace, "v1", "ConfigMap", ref.Name,
-				fmt.Sprintf("%s:%s", ref.Name, ref.Key))
-			if err != nil {
-				return nil, err
-			}
-
-			objectStore := options.DashConfig.ObjectStore()
-
-			key := store.Key{
-				Name:       ref.Name,
-				Namespace:  namespace,
-				APIVersion: "v1",
-				Kind:       "ConfigMap",
-			}
-
-			u, err := objectStore.Get(ctx, key)
+			u, source, err := describeConfigReference(ctx, namespace, "ConfigMap", ref.Name,
+				fmt.Sprintf("%s:%s", ref.Name, ref.Key), ref.Optional, options)
 			if err != nil {
 				return nil, err
 			}
+			row["Source"] = source
 
 			if u != nil {
 				configMap := &corev1.ConfigMap{}
@@ -417,10 +473,8 @@ func describeEnvRows(ctx context.Context, namespace string, vars []corev1.EnvVar
 				}
 
 				row["Value"] = component.NewText(configMap.Data[ref.Key])
-				row["Source"] = source
 			} else {
 				row["Value"] = component.NewText("<none>")
-				row["Source"] = component.NewText(fmt.Sprintf("%s:%s", ref.Name, ref.Key))
 			}
 		}
 	}
@@ -430,7 +484,7 @@ func describeEnvRows(ctx context.Context, namespace string, vars []corev1.EnvVar
 
 // describeEnvFromRows renders container environmentFrom references as table rows.
 // Expected columns: Name, Value, Source
-func describeEnvFromRows(namespace string, vars []corev1.EnvFromSource, options Options) ([]component.TableRow, error) {
+func describeEnvFromRows(ctx context.Context, namespace string, vars []corev1.EnvFromSource, options Options) ([]component.TableRow, error) {
 	rows := make([]component.TableRow, 0)
 	for _, e := range vars {
 		row := component.TableRow{}
@@ -439,14 +493,14 @@ func describeEnvFromRows(namespace string, vars []corev1.EnvFromSource, options
 		switch {
 		case e.SecretRef != nil:
 			ref := e.SecretRef
-			source, err := options.Link.ForGVK(namespace, "v1", "Secret", ref.Name, ref.Name)
+			_, source, err := describeConfigReference(ctx, namespace, "Secret", ref.Name, ref.Name, ref.Optional, options)
 			if err != nil {
 				return nil, err
 			}
 			row["Source"] = source
 		case e.ConfigMapRef != nil:
 			ref := e.ConfigMapRef
-			source, err := options.Link.ForGVK(namespace, "v1", "ConfigMap", ref.Name, ref.Name)
+			_, source, err := describeConfigReference(ctx, namespace, "ConfigMap", ref.Name, ref.Name, ref.Optional, options)
 			if err != nil {
 				return nil, err
 			}
@@ -457,6 +511,48 @@ func describeEnvFromRows(namespace string, vars []corev1.EnvFromSource, options
 	return rows, nil
 }
 
+// describeConfigReference resolves a reference to a ConfigMap or Secret against the cache.
+// References to objects in the cache link to the object. References to missing objects are
+// errors if they are required, since the pod won't start without them, and warnings if they
+// are optional. Optional references are labeled as optional. The referenced object is
+// returned if it is found.
+func describeConfigReference(ctx context.Context, namespace, kind, name, text string, optional *bool, options Options) (*unstructured.Unstructured, component.Component, error) {
+	isOptional := optional != nil && *optional
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       kind,
+		Name:       name,
+	}
+
+	u, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if u == nil {
+		missing := component.NewText(fmt.Sprintf("%s (missing)", text))
+		missing.SetStatus(component.TextStatusError)
+		if isOptional {
+			missing = component.NewText(fmt.Sprintf("%s (optional, missing)", text))
+			missing.SetStatus(component.TextStatusWarning)
+		}
+		return nil, missing, nil
+	}
+
+	if isOptional {
+		text = fmt.Sprintf("%s (optional)", text)
+	}
+
+	link, err := options.Link.ForGVK(namespace, "v1", kind, name, text)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return u, link, nil
+}
+
 // printSlice returns a string representation of a string slice, in a format similar
 // to ['a', 'b', 'c']. An empty slice will be returned as an empty string, rather than [].
 func printSlice(s []string) string {
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/octant"
@@ -33,6 +34,8 @@ func Test_ContainerConfiguration(t *testing.T) {
 		validContainer = &corev1.Container{
 			Name:  "nginx",
 			Image: "nginx:1.15",
+			Stdin: true,
+			TTY:   true,
 			Ports: []corev1.ContainerPort{
 				{
 					Name:     "http",
@@ -231,6 +234,14 @@ func Test_ContainerConfiguration(t *testing.T) {
 					Header:  "Restart Count",
 					Content: component.NewText("2"),
 				},
+				{
+					Header: "Last Termination Message",
+					Content: func() *component.Text {
+						text := component.NewText("panic: config not found")
+						text.SetStatus(component.TextStatusError)
+						return text
+					}(),
+				},
 				{
 					Header:  "Environment",
 					Content: envTable,
@@ -243,6 +254,10 @@ func Test_ContainerConfiguration(t *testing.T) {
 					Header:  "Args",
 					Content: component.NewText("['-v', '-p', '80']"),
 				},
+				{
+					Header:  "Interactive",
+					Content: component.NewText("Attachable with TTY"),
+				},
 				{
 					Header:  "Volume Mounts",
 					Content: volTable,
@@ -270,6 +285,10 @@ func Test_ContainerConfiguration(t *testing.T) {
 					Header:  "Args",
 					Content: component.NewText("['-c', 'until nslookup mydb; do echo waiting for mydb; sleep 2; done;']"),
 				},
+				{
+					Header:  "Interactive",
+					Content: component.NewText("Not attachable: attach will have no stdin or TTY"),
+				},
 			}...),
 		},
 		{
@@ -345,6 +364,7 @@ func Test_ContainerConfiguration(t *testing.T) {
 							Terminated: &corev1.ContainerStateTerminated{
 								FinishedAt: metav1.Time{Time: now},
 								Reason:     "reason",
+								Message:    "panic: config not found\n",
 								ExitCode:   255,
 							},
 						},
@@ -360,6 +380,18 @@ func Test_ContainerConfiguration(t *testing.T) {
 					Namespace:  configMap.Namespace,
 				}
 				tpo.objectStore.EXPECT().Get(ctx, gomock.Eq(key)).Return(testutil.ToUnstructured(t, configMap), nil).AnyTimes()
+
+				for _, object := range []*unstructured.Unstructured{
+					testutil.ToUnstructured(t, testutil.CreateSecret("mysecret")),
+					testutil.ToUnstructured(t, testutil.CreateSecret("fromsecret")),
+					testutil.ToUnstructured(t, testutil.CreateConfigMap("fromconfig")),
+				} {
+					referenceKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: object.GetKind(), Name: object.GetName()}
+					tpo.objectStore.EXPECT().Get(ctx, gomock.Eq(referenceKey)).Return(object, nil).AnyTimes()
+				}
+
+				podKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+				tpo.objectStore.EXPECT().Get(ctx, gomock.Eq(podKey)).Return(nil, nil).AnyTimes()
 			}
 
 			cc := NewContainerConfiguration(ctx, parentPod, tc.container, pf, IsInit(tc.isInit), WithPrintOptions(printOptions))
@@ -500,3 +532,180 @@ func createPortForwardState(id, namespace, targetName string, gvk schema.GroupVe
 			Name:      targetName,
 		}}
 }
+
+func Test_describeConfigReference(t *testing.T) {
+	optional := true
+
+	statusText := func(value string, status component.TextStatus) *component.Text {
+		text := component.NewText(value)
+		text.SetStatus(status)
+		return text
+	}
+
+	tests := []struct {
+		name     string
+		optional *bool
+		found    bool
+		expected component.Component
+	}{
+		{
+			name:     "required and found",
+			found:    true,
+			expected: component.NewLink("", "config:key", "/config"),
+		},
+		{
+			name:     "optional and found",
+			optional: &optional,
+			found:    true,
+			expected: component.NewLink("", "config:key (optional)", "/config"),
+		},
+		{
+			name:     "required and missing",
+			expected: statusText("config:key (missing)", component.TextStatusError),
+		},
+		{
+			name:     "optional and missing",
+			optional: &optional,
+			expected: statusText("config:key (optional, missing)", component.TextStatusWarning),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			var object *unstructured.Unstructured
+			if test.found {
+				object = testutil.ToUnstructured(t, testutil.CreateConfigMap("config"))
+				tpo.PathForGVK("namespace", "v1", "ConfigMap", "config", test.expected.(*component.Link).Config.Text, "/config")
+			}
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "config"}
+			tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+
+			u, got, err := describeConfigReference(ctx, "namespace", "ConfigMap", "config", "config:key", test.optional, tpo.ToOptions())
+			require.NoError(t, err)
+
+			require.Equal(t, object, u)
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
+func Test_describeContainerTerminationMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   corev1.TerminationMessagePolicy
+		state    corev1.ContainerState
+		expected func() *component.Text
+	}{
+		{
+			name: "not terminated",
+			state: corev1.ContainerState{
+				Running: &corev1.ContainerStateRunning{},
+			},
+		},
+		{
+			name: "exited successfully",
+			state: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Message: "done"},
+			},
+			expected: func() *component.Text {
+				return component.NewText("done")
+			},
+		},
+		{
+			name: "exited with error",
+			state: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: "panic: config not found"},
+			},
+			expected: func() *component.Text {
+				text := component.NewText("panic: config not found")
+				text.SetStatus(component.TextStatusError)
+				return text
+			},
+		},
+		{
+			name: "empty message",
+			state: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 137},
+			},
+			expected: func() *component.Text {
+				text := component.NewText("—")
+				text.SetStatus(component.TextStatusError)
+				return text
+			},
+		},
+		{
+			name:   "fallback to logs on error",
+			policy: corev1.TerminationMessageFallbackToLogsOnError,
+			state: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 2, Message: "last log line"},
+			},
+			expected: func() *component.Text {
+				text := component.NewText("last log line (FallbackToLogsOnError: falls back to the end of the log on error)")
+				text.SetStatus(component.TextStatusError)
+				return text
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &corev1.Container{Name: "container", TerminationMessagePolicy: test.policy}
+
+			got, found := describeContainerTerminationMessage(c, test.state)
+			if test.expected == nil {
+				require.False(t, found)
+				return
+			}
+
+			require.True(t, found)
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+func Test_describeContainerInteractive(t *testing.T) {
+	tests := []struct {
+		name      string
+		container corev1.Container
+		expected  string
+	}{
+		{
+			name:      "stdin and tty",
+			container: corev1.Container{Stdin: true, TTY: true},
+			expected:  "Attachable with TTY",
+		},
+		{
+			name:      "stdin once",
+			container: corev1.Container{Stdin: true, StdinOnce: true, TTY: true},
+			expected:  "Attachable with TTY, stdin closes after the first attach",
+		},
+		{
+			name:      "stdin without tty",
+			container: corev1.Container{Stdin: true},
+			expected:  "Attachable without TTY",
+		},
+		{
+			name:      "tty without stdin",
+			container: corev1.Container{TTY: true},
+			expected:  "Not attachable: TTY without stdin",
+		},
+		{
+			name:     "no stdin or tty",
+			expected: "Not attachable: attach will have no stdin or TTY",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := describeContainerInteractive(&test.container)
+			component.AssertEqual(t, component.NewText(test.expected), got)
+		})
+	}
+}
@@ -29,6 +29,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 
 	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age")
 	ot := NewObjectTable("CronJobs", "We couldn't find any cron jobs!", cols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
 
 	for _, c := range list.Items {
 		row := component.TableRow{}
@@ -40,7 +41,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(c.Labels)
+		row["Labels"] = createLabelsView(&c, c.Labels, opts)
 
 		row["Schedule"] = component.NewText(c.Spec.Schedule)
 
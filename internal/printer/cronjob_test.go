@@ -247,7 +247,7 @@ func Test_createJobListView(t *testing.T) {
 	got, err := createJobListView(ctx, cronJob, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Labels", "Completions", "Successful", "Age")
+	cols := component.NewTableCols("Name", "Labels", "Status", "Completions", "Successful", "Age")
 	expected := component.NewTable("Jobs", "We couldn't find any jobs!", cols)
 	expected.Add(component.TableRow{
 		"Name": component.NewLink("", "job", "/job",
@@ -257,6 +257,7 @@ func Test_createJobListView(t *testing.T) {
 			}),
 		),
 		"Labels":      component.NewLabels(labels),
+		"Status":      component.NewStatusBadges(""),
 		"Completions": component.NewText("1"),
 		"Successful":  component.NewText("1"),
 		"Age":         component.NewTimestamp(now),
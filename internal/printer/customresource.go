@@ -11,18 +11,19 @@ import (
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/util/jsonpath"
 
-	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	octantStrings "github.com/vmware-tanzu/octant/internal/util/strings"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 // CreateCustomResourceList prints a list of custom resources as a table with
-// optional custom columns.
-func CreateCustomResourceList(crdObject *unstructured.Unstructured, resources *unstructured.UnstructuredList, version string, linkGenerator link.Interface) (component.Component, error) {
+// optional custom columns. Resources outside of the age window in options are not listed.
+func CreateCustomResourceList(crdObject *unstructured.Unstructured, resources *unstructured.UnstructuredList, version string, options Options) (component.Component, error) {
 	if crdObject == nil {
 		return nil, fmt.Errorf("custom resource definition is nil")
 	}
@@ -53,6 +54,9 @@ func CreateCustomResourceList(crdObject *unstructured.Unstructured, resources *u
 		table.AddColumn("Age")
 	}
 
+	ageFilter := newAgeFilter(options)
+	ageFilter.addBucketFilter(table)
+
 	for i := range resources.Items {
 		versionName := resources.Items[i].GroupVersionKind().Version
 		if version != versionName {
@@ -66,8 +70,11 @@ func CreateCustomResourceList(crdObject *unstructured.Unstructured, resources *u
 
 		cr := resources.Items[i]
 		row := component.TableRow{}
+		if !ageFilter.filterRow(cr.GetCreationTimestamp().Time, row) {
+			continue
+		}
 
-		name, err := linkGenerator.ForObject(&cr, cr.GetName())
+		name, err := options.Link.ForObject(&cr, cr.GetName())
 		if err != nil {
 			return nil, err
 		}
@@ -101,29 +108,56 @@ func CreateCustomResourceList(crdObject *unstructured.Unstructured, resources *u
 }
 
 func printCustomColumn(m interface{}, column octant.CustomResourceDefinitionPrinterColumn) (string, error) {
-	j := jsonpath.New(column.Name)
+	s, found, err := executeJSONPath(column.Name, column.JSONPath, m)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "<not found>", nil
+	}
+
+	return s, nil
+}
+
+// executeJSONPath evaluates a JSONPath template against m. Paths may be given with or
+// without braces. If the path doesn't resolve, found is false.
+func executeJSONPath(name, path string, m interface{}) (s string, found bool, err error) {
+	j := jsonpath.New(name)
 	buf := bytes.Buffer{}
 
-	s := strings.Replace(column.JSONPath, "\\", "", -1)
+	path = strings.Replace(path, "\\", "", -1)
+	if !strings.HasPrefix(path, "{") {
+		path = fmt.Sprintf("{%s}", path)
+	}
 
-	if err := j.Parse(fmt.Sprintf("{%s}", s)); err != nil {
-		return "", fmt.Errorf("json path parse error for '%s': %w", s, err)
+	if err := j.Parse(path); err != nil {
+		return "", false, fmt.Errorf("json path parse error for '%s': %w", path, err)
 	}
 	if err := j.Execute(&buf, m); err != nil {
 		// inspecting the error string because jsonpath doesn't do typed errors
 		if strings.Contains(err.Error(), "is not found") {
-			return "<not found>", nil
+			return "", false, nil
 		}
 
-		return "", fmt.Errorf("json path execute error: %w", err)
+		return "", false, fmt.Errorf("json path execute error: %w", err)
 	}
 
-	return buf.String(), nil
+	return buf.String(), true, nil
 }
 
-// CustomResourceHandler prints custom resource objects. If the
-// object has columns specified, it will print those columns as well.
+// CustomResourceHandler prints custom resource objects. If a print func has
+// been registered for the custom resource's group/version/kind, it will be used.
+// Otherwise, if the object has columns specified, it will print those columns as well.
+// Links are resolved with options.PathResolver when it is set.
 func CustomResourceHandler(ctx context.Context, crd, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	options = options.withPathResolver()
+
+	if cr != nil {
+		if printFunc, ok := options.CustomResourceHandlers.PrintFunc(cr.GroupVersionKind()); ok {
+			return printFunc(ctx, cr, options)
+		}
+	}
+
 	object := NewObject(cr)
 	object.EnableEvents()
 
@@ -262,3 +296,48 @@ func crdVersion(crd, cr *unstructured.Unstructured) (octant.CustomResourceDefini
 
 	return crdVersion, nil
 }
+
+// customResourceCondition is the condition shape used by the status of most
+// custom resources. It mirrors metav1.Condition.
+type customResourceCondition struct {
+	Type               string                 `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	ObservedGeneration int64                  `json:"observedGeneration,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+}
+
+// findCustomResourceCondition returns the condition with a type from a list of conditions.
+func findCustomResourceCondition(conditions []customResourceCondition, conditionType string) (customResourceCondition, bool) {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition, true
+		}
+	}
+
+	return customResourceCondition{}, false
+}
+
+var (
+	customResourceConditionsCols = component.NewTableCols("Type", "Status", "Last Transition Time", "Message", "Reason")
+)
+
+// createCustomResourceConditionsView creates a table for custom resource conditions.
+func createCustomResourceConditionsView(conditions []customResourceCondition) *component.Table {
+	table := component.NewTable("Conditions", "There are no conditions!", customResourceConditionsCols)
+
+	for _, condition := range conditions {
+		row := component.TableRow{
+			"Type":                 component.NewText(condition.Type),
+			"Status":               component.NewText(string(condition.Status)),
+			"Last Transition Time": component.NewTimestamp(condition.LastTransitionTime.Time),
+			"Message":              component.NewText(condition.Message),
+			"Reason":               component.NewText(condition.Reason),
+		}
+
+		table.Add(row)
+	}
+
+	return table
+}
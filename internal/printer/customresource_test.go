@@ -6,6 +6,8 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
+	"path"
 	"testing"
 	"time"
 
@@ -13,7 +15,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -37,7 +43,7 @@ func Test_CustomResourceListHandler(t *testing.T) {
 	resource.SetLabels(labels)
 
 	list := testutil.ToUnstructuredList(t, resource)
-	got, err := CreateCustomResourceList(crd, list, "v1", tpo.link)
+	got, err := CreateCustomResourceList(crd, list, "v1", tpo.ToOptions())
 	require.NoError(t, err)
 
 	expected := component.NewTableWithRows(
@@ -73,7 +79,7 @@ func Test_CustomResourceListHandler_custom_columns(t *testing.T) {
 
 	list := testutil.ToUnstructuredList(t, resource)
 
-	got, err := CreateCustomResourceList(crd, list, "v1", tpo.link)
+	got, err := CreateCustomResourceList(crd, list, "v1", tpo.ToOptions())
 	require.NoError(t, err)
 
 	expected := component.NewTableWithRows(
@@ -98,6 +104,92 @@ func TestCustomResourceHandler(t *testing.T) {
 
 }
 
+func TestCustomResourceHandlers(t *testing.T) {
+	groupVersionKind := schema.GroupVersionKind{Group: "stable.example.com", Version: "v1", Kind: "CronTab"}
+
+	printFunc := func(context.Context, *unstructured.Unstructured, Options) (component.Component, error) {
+		return component.NewText("crontab"), nil
+	}
+
+	handlers := NewCustomResourceHandlers()
+	require.NoError(t, handlers.Handler(groupVersionKind, printFunc))
+	require.Error(t, handlers.Handler(groupVersionKind, printFunc), "duplicate handler")
+	require.Error(t, handlers.Handler(schema.GroupVersionKind{Group: "stable.example.com"}, printFunc), "invalid gvk")
+	require.Error(t, handlers.Handler(schema.GroupVersionKind{Version: "v1", Kind: "Other"}, nil), "nil print func")
+
+	_, ok := handlers.PrintFunc(groupVersionKind)
+	require.True(t, ok)
+
+	_, ok = handlers.PrintFunc(schema.GroupVersionKind{Group: "stable.example.com", Version: "v2", Kind: "CronTab"})
+	require.False(t, ok)
+
+	crd := testutil.LoadUnstructuredFromFile(t, "crd.yaml")
+	resource := testutil.LoadUnstructuredFromFile(t, "crd-resource.yaml")
+
+	got, err := CustomResourceHandler(context.Background(), crd, resource, Options{CustomResourceHandlers: handlers})
+	require.NoError(t, err)
+	component.AssertEqual(t, component.NewText("crontab"), got)
+}
+
+func TestCustomResourceHandler_pathResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	tpo.PathForGVK("namespace", "v1", "Pod", "pod", "pod", "/pod")
+
+	groupVersionKind := schema.GroupVersionKind{Group: "stable.example.com", Version: "v1", Kind: "CronTab"}
+
+	printFunc := func(_ context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+		crontab, err := options.Link.ForGVK(cr.GetNamespace(), cr.GetAPIVersion(), cr.GetKind(), cr.GetName(), cr.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		pod, err := options.Link.ForGVK("namespace", "v1", "Pod", "pod", "pod")
+		if err != nil {
+			return nil, err
+		}
+
+		list := component.NewList(nil, nil)
+		list.Add(crontab, pod)
+		return list, nil
+	}
+
+	handlers := NewCustomResourceHandlers()
+	require.NoError(t, handlers.Handler(groupVersionKind, printFunc))
+
+	options := tpo.ToOptions()
+	options.CustomResourceHandlers = handlers
+	options.PathResolver = func(namespace, apiVersion, kind, name string) (string, error) {
+		if kind != groupVersionKind.Kind {
+			return "", nil
+		}
+		return path.Join("/plugin-name/crontabs", namespace, name), nil
+	}
+
+	crd := testutil.LoadUnstructuredFromFile(t, "crd.yaml")
+	resource := testutil.LoadUnstructuredFromFile(t, "crd-resource.yaml")
+
+	got, err := CustomResourceHandler(context.Background(), crd, resource, options)
+	require.NoError(t, err)
+
+	expected := component.NewList(nil, nil)
+	expected.Add(
+		component.NewLink("", resource.GetName(), path.Join("/plugin-name/crontabs", resource.GetNamespace(), resource.GetName())),
+		component.NewLink("", "pod", "/pod"),
+	)
+	component.AssertEqual(t, expected, got)
+}
+
+func TestAddCustomResourceHandlers(t *testing.T) {
+	handlers := NewCustomResourceHandlers()
+	require.NoError(t, AddCustomResourceHandlers(handlers))
+
+	_, ok := handlers.PrintFunc(gvk.VerticalPodAutoscaler)
+	require.True(t, ok)
+}
+
 func Test_printCustomResourceConfig(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -245,3 +337,35 @@ func Test_printCustomColumn(t *testing.T) {
 	}
 
 }
+
+func Test_CustomResourceListHandler_ageFilter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	crd := testutil.LoadUnstructuredFromFile(t, "crd.yaml")
+
+	now := testutil.Time()
+
+	recent := testutil.LoadUnstructuredFromFile(t, "crd-resource.yaml")
+	recent.SetName("recent")
+	recent.SetCreationTimestamp(metav1.NewTime(now.Add(-10 * time.Minute)))
+
+	old := recent.DeepCopy()
+	old.SetName("old")
+	old.SetCreationTimestamp(metav1.NewTime(now.Add(-30 * 24 * time.Hour)))
+
+	tpo.PathForObject(recent, recent.GetName(), "/recent")
+
+	options := tpo.ToOptions()
+	options.MaxAge = 7 * 24 * time.Hour
+	options.AgeBuckets = true
+	options.Clock = clock.NewFakeClock(now)
+
+	list := testutil.ToUnstructuredList(t, recent, old)
+	got, err := CreateCustomResourceList(crd, list, "v1", options)
+	require.NoError(t, err)
+
+	assertAgeBuckets(t, got, []string{ageBucketHour})
+}
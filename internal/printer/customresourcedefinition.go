@@ -35,6 +35,7 @@ func CustomResourceDefinitionListHandler(
 		"We couldn't find any custom resource definitions!",
 		cols,
 		opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
 
 	for _, crd := range list.Items {
 		row := component.TableRow{}
@@ -127,7 +128,7 @@ func CustomResourceDefinitionVersionList(
 			return nil, err
 		}
 
-		view, err := CreateCustomResourceList(crd, customResources, version, options.Link)
+		view, err := CreateCustomResourceList(crd, customResources, version, options)
 		if err != nil {
 			return nil, err
 		}
@@ -13,6 +13,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -25,6 +26,8 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Ready",
 		"Up-To-Date", "Age", "Node Selector")
 	ot := NewObjectTable("Daemon Sets", "We couldn't find any daemon sets!", cols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
+	ot.SetStatusThresholds(opts)
 
 	for _, daemonSet := range list.Items {
 		row := component.TableRow{}
@@ -34,10 +37,12 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(daemonSet.Labels)
+		row["Labels"] = createLabelsView(&daemonSet, daemonSet.Labels, opts)
 		row["Desired"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.DesiredNumberScheduled))
 		row["Current"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.CurrentNumberScheduled))
-		row["Ready"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.NumberReady))
+		ready := component.NewTextf("%d", daemonSet.Status.NumberReady)
+		setReplicaStatus(ready, opts, gvk.DaemonSet, int(daemonSet.Status.NumberReady), int(daemonSet.Status.DesiredNumberScheduled))
+		row["Ready"] = ready
 		row["Up-To-Date"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.UpdatedNumberScheduled))
 		row["Age"] = component.NewTimestamp(daemonSet.ObjectMeta.CreationTimestamp.Time)
 		row["Node Selector"] = printSelectorMap(daemonSet.Spec.Template.Spec.NodeSelector)
@@ -54,6 +59,7 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 func DaemonSetHandler(ctx context.Context, daemonSet *appsv1.DaemonSet, options Options) (component.Component, error) {
 	o := NewObject(daemonSet)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	dsh, err := newDaemonSetHandler(daemonSet, o)
 	if err != nil {
@@ -88,14 +94,14 @@ func NewDaemonSetConfiguration(ds *appsv1.DaemonSet) *DaemonSetConfiguration {
 }
 
 // Create generates a daemonset configuration summary
-func (dc *DaemonSetConfiguration) Create() (*component.Summary, error) {
+func (dc *DaemonSetConfiguration) Create(options Options) (*component.Summary, error) {
 	if dc == nil || dc.daemonset == nil {
 		return nil, errors.New("daemon set is nil")
 	}
 
 	ds := dc.daemonset
 
-	sections := component.SummarySections{}
+	sections := component.SummarySections(createMetadataSection(ds, options).Config.Sections)
 
 	rollingUpdate := ds.Spec.UpdateStrategy.RollingUpdate
 	if rollingUpdate != nil {
@@ -117,6 +123,8 @@ func (dc *DaemonSetConfiguration) Create() (*component.Summary, error) {
 		sections.Add("Node Selectors", printSelectorMap(selector))
 	}
 
+	sections.AddText("Pod Template Hash", podTemplateHash(ds.Spec.Template))
+
 	summary := component.NewSummary("Configuration", sections...)
 
 	return summary, nil
@@ -188,7 +196,7 @@ func (d *daemonSetHandler) Config(options Options) error {
 }
 
 func defaultDaemonSetConfig(daemonSet *appsv1.DaemonSet, options Options) (*component.Summary, error) {
-	return NewDaemonSetConfiguration(daemonSet).Create()
+	return NewDaemonSetConfiguration(daemonSet).Create(options)
 }
 
 func (d *daemonSetHandler) Status(options Options) error {
@@ -208,6 +216,41 @@ func defaultDaemonSetSummary(daemonSet *appsv1.DaemonSet, option Options) (*comp
 func (d *daemonSetHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
 	d.object.EnablePodTemplate(d.daemonSet.Spec.Template)
 
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createConfigDriftView(ctx, d.daemonSet.Namespace, d.daemonSet.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createImageRegistryView(d.daemonSet.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createServiceReferencesView(ctx, d.daemonSet.Namespace, d.daemonSet.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createSecurityPostureView(ctx, d.daemonSet, d.daemonSet.Spec.Selector, d.daemonSet.Spec.Template.Spec, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createRevisionHistoryView(ctx, d.daemonSet, "", "", options)
+		},
+	})
+
 	d.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
 		Func: func() (component.Component, error) {
@@ -95,6 +95,13 @@ func Test_DaemonSetConfiguration(t *testing.T) {
 			name:      "daemonset",
 			daemonSet: ds,
 			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{Header: "Name", Content: component.NewText("ds")},
+				{Header: "Namespace", Content: component.NewText("namespace")},
+				{Header: "UID", Content: component.NewText("ds")},
+				{Header: "Created", Content: component.NewTimestamp(now)},
+				{Header: "Generation", Content: component.NewText("<none>")},
+				{Header: "Labels", Content: component.NewLabels(labels)},
+				{Header: "Annotations", Content: component.NewText("<none>")},
 				{
 					Header:  "Update Strategy",
 					Content: component.NewText("Max Unavailable 1"),
@@ -111,6 +118,10 @@ func Test_DaemonSetConfiguration(t *testing.T) {
 					Header:  "Node Selectors",
 					Content: printSelectorMap(labels),
 				},
+				{
+					Header:  "Pod Template Hash",
+					Content: component.NewText(podTemplateHash(ds.Spec.Template)),
+				},
 			}...),
 		},
 		{
@@ -124,7 +135,7 @@ func Test_DaemonSetConfiguration(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			dc := NewDaemonSetConfiguration(tc.daemonSet)
 
-			summary, err := dc.Create()
+			summary, err := dc.Create(Options{})
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -9,11 +9,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -33,6 +35,8 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	ot := NewObjectTable("Deployments", "We couldn't find any deployments!", cols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
+	ot.SetStatusThresholds(opts)
 
 	for _, d := range list.Items {
 		row := component.TableRow{}
@@ -42,10 +46,9 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(d.Labels)
+		row["Labels"] = createLabelsView(&d, d.Labels, opts)
 
-		status := fmt.Sprintf("%d/%d", d.Status.AvailableReplicas, d.Status.AvailableReplicas+d.Status.UnavailableReplicas)
-		row["Status"] = component.NewText(status)
+		row["Status"] = deploymentStatusBadges(&d, opts)
 
 		ts := d.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
@@ -66,20 +69,70 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 	return ot.ToComponent()
 }
 
+// deploymentStatusBadges prints the replica count along with a badge for each
+// active condition. Conditions which are not active are only shown when they
+// report a failure. If a status threshold is set for deployments, the availability
+// badge's status is set by the share of replicas which are available.
+func deploymentStatusBadges(deployment *appsv1.Deployment, options Options) *component.StatusBadges {
+	status := deployment.Status
+	total := status.AvailableReplicas + status.UnavailableReplicas
+	badges := component.NewStatusBadges(fmt.Sprintf("%d/%d", status.AvailableReplicas, total))
+
+	availability := component.TextStatusOK
+	if threshold, ok := options.configuredStatusThreshold(gvk.Deployment); ok {
+		availability = threshold.Status(int(status.AvailableReplicas), int(total))
+	}
+
+	for _, condition := range status.Conditions {
+		switch {
+		case condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionTrue:
+			badges.Add(string(condition.Type), availability, condition.Message)
+		case condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionFalse:
+			if availability == component.TextStatusOK {
+				availability = component.TextStatusWarning
+			}
+			badges.Add(conditionBadgeLabel("Not "+string(condition.Type), condition.Reason), availability, condition.Message)
+		case condition.Type == appsv1.DeploymentReplicaFailure:
+			if condition.Status == corev1.ConditionTrue {
+				badges.Add(conditionBadgeLabel(string(condition.Type), condition.Reason), component.TextStatusError, condition.Message)
+			}
+		case condition.Status == corev1.ConditionTrue:
+			badges.Add(string(condition.Type), component.TextStatusOK, condition.Message)
+		case condition.Status == corev1.ConditionFalse:
+			badgeStatus := component.TextStatusWarning
+			if condition.Reason == "ProgressDeadlineExceeded" {
+				badgeStatus = component.TextStatusError
+			}
+			badges.Add(conditionBadgeLabel("Not "+string(condition.Type), condition.Reason), badgeStatus, condition.Message)
+		}
+	}
+
+	return badges
+}
+
+// conditionBadgeLabel returns a badge label for a condition which includes its reason.
+func conditionBadgeLabel(label, reason string) string {
+	if reason == "" {
+		return label
+	}
+	return fmt.Sprintf("%s (%s)", label, reason)
+}
+
 // DeploymentHandler is a printFunc that prints a Deployments.
 func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, options Options) (component.Component, error) {
 	o := NewObject(deployment)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	dh, err := newDeploymentHandler(deployment, o)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := dh.Config(); err != nil {
+	if err := dh.Config(options); err != nil {
 		return nil, errors.Wrap(err, "print deployment configuration")
 	}
-	if err := dh.Status(); err != nil {
+	if err := dh.Status(options); err != nil {
 		return nil, errors.Wrap(err, "print deployment status")
 	}
 	if err := dh.Pods(ctx, deployment, options); err != nil {
@@ -92,17 +145,22 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	return o.ToComponent(ctx, options)
 }
 
-func createDeploymentSummaryStatus(deployment *appsv1.Deployment) (*component.Summary, error) {
+func createDeploymentSummaryStatus(deployment *appsv1.Deployment, options Options) (*component.Summary, error) {
 	if deployment == nil {
 		return nil, errors.New("unable to generate status from a nil deployment")
 	}
 
 	status := deployment.Status
 
+	available := component.NewText(fmt.Sprintf("%d", status.AvailableReplicas))
+	if threshold, ok := options.configuredStatusThreshold(gvk.Deployment); ok {
+		available.SetStatus(threshold.Status(int(status.AvailableReplicas), int(replicasOrDefault(deployment.Spec.Replicas))))
+	}
+
 	summary := component.NewSummary("Status", []component.SummarySection{
 		{
 			Header:  "Available Replicas",
-			Content: component.NewText(fmt.Sprintf("%d", status.AvailableReplicas)),
+			Content: available,
 		},
 		{
 			Header:  "Ready Replicas",
@@ -167,12 +225,12 @@ func NewDeploymentConfiguration(d *appsv1.Deployment) *DeploymentConfiguration {
 }
 
 // Create creates a deployment configuration summary.
-func (dc *DeploymentConfiguration) Create() (*component.Summary, error) {
+func (dc *DeploymentConfiguration) Create(options Options) (*component.Summary, error) {
 	if dc.deployment == nil {
 		return nil, errors.New("deployment is nil")
 	}
 
-	sections := make([]component.SummarySection, 0)
+	sections := createMetadataSection(dc.deployment, options).Config.Sections
 
 	strategyType := dc.deployment.Spec.Strategy.Type
 	sections = append(sections, component.SummarySection{
@@ -246,6 +304,11 @@ func (dc *DeploymentConfiguration) Create() (*component.Summary, error) {
 		Content: component.NewText(fmt.Sprintf("%d", replicas)),
 	})
 
+	sections = append(sections, component.SummarySection{
+		Header:  "Pod Template Hash",
+		Content: component.NewText(podTemplateHash(dc.deployment.Spec.Template)),
+	})
+
 	summary := component.NewSummary("Configuration", sections...)
 
 	for _, generator := range dc.actionGenerators {
@@ -284,16 +347,16 @@ func editDeploymentAction(deployment *appsv1.Deployment) ([]component.Action, er
 }
 
 type deploymentObject interface {
-	Config() error
-	Status() error
+	Config(options Options) error
+	Status(options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
 	Conditions() error
 }
 
 type deploymentHandler struct {
 	deployment     *appsv1.Deployment
-	configFunc     func(*appsv1.Deployment) (*component.Summary, error)
-	summaryFunc    func(*appsv1.Deployment) (*component.Summary, error)
+	configFunc     func(*appsv1.Deployment, Options) (*component.Summary, error)
+	summaryFunc    func(*appsv1.Deployment, Options) (*component.Summary, error)
 	podFunc        func(context.Context, []runtime.Object, Options) (component.Component, error)
 	conditionsFunc func(*appsv1.Deployment) (*component.Table, error)
 	object         *Object
@@ -322,8 +385,8 @@ func newDeploymentHandler(deployment *appsv1.Deployment, object *Object) (*deplo
 	return dh, nil
 }
 
-func (d *deploymentHandler) Config() error {
-	out, err := d.configFunc(d.deployment)
+func (d *deploymentHandler) Config(options Options) error {
+	out, err := d.configFunc(d.deployment, options)
 	if err != nil {
 		return err
 	}
@@ -332,12 +395,12 @@ func (d *deploymentHandler) Config() error {
 	return nil
 }
 
-func defaultDeploymentConfig(deployment *appsv1.Deployment) (*component.Summary, error) {
-	return NewDeploymentConfiguration(deployment).Create()
+func defaultDeploymentConfig(deployment *appsv1.Deployment, options Options) (*component.Summary, error) {
+	return NewDeploymentConfiguration(deployment).Create(options)
 }
 
-func (d *deploymentHandler) Status() error {
-	out, err := d.summaryFunc(d.deployment)
+func (d *deploymentHandler) Status(options Options) error {
+	out, err := d.summaryFunc(d.deployment, options)
 	if err != nil {
 		return err
 	}
@@ -346,8 +409,8 @@ func (d *deploymentHandler) Status() error {
 	return nil
 }
 
-func defaultDeploymentSummary(deployment *appsv1.Deployment) (*component.Summary, error) {
-	return createDeploymentSummaryStatus(deployment)
+func defaultDeploymentSummary(deployment *appsv1.Deployment, options Options) (*component.Summary, error) {
+	return createDeploymentSummaryStatus(deployment, options)
 }
 
 func (d *deploymentHandler) Conditions() error {
@@ -372,6 +435,62 @@ func defaultDeploymentConditions(deployment *appsv1.Deployment) (*component.Tabl
 func (d *deploymentHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
 	d.object.EnablePodTemplate(d.deployment.Spec.Template)
 
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthQuarter,
+		Func: func() (component.Component, error) {
+			return createWorkloadIndicators(ctx, d.deployment, d.deployment.Spec.Selector, replicasOrDefault(d.deployment.Spec.Replicas), options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createTopologySpreadView(ctx, d.deployment.Namespace, d.deployment.Spec.Template.Spec, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createReplicaSpreadView(ctx, d.deployment.Namespace, d.deployment.Spec.Selector, d.deployment.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createOwnerTreeView(ctx, d.deployment, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createConfigDriftView(ctx, d.deployment.Namespace, d.deployment.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createImageRegistryView(d.deployment.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createServiceReferencesView(ctx, d.deployment.Namespace, d.deployment.Spec.Template, options)
+		},
+	})
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createSecurityPostureView(ctx, d.deployment, d.deployment.Spec.Selector, d.deployment.Spec.Template.Spec, options)
+		},
+	})
+
 	replicaSets, err := listReplicaSetsAsObjects(ctx, d.deployment, options)
 	if replicaSets == nil || err != nil {
 		return err
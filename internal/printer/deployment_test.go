@@ -21,8 +21,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -58,6 +60,13 @@ func Test_DeploymentListHandler(t *testing.T) {
 			Replicas:            3,
 			AvailableReplicas:   2,
 			UnavailableReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:   appsv1.DeploymentProgressing,
+					Status: corev1.ConditionTrue,
+					Reason: "NewReplicaSetAvailable",
+				},
+			},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: conversion.PtrInt32(3),
@@ -103,10 +112,11 @@ func Test_DeploymentListHandler(t *testing.T) {
 		"Name": component.NewLink("", "deployment", "/path",
 			genObjectStatus(component.TextStatusWarning, []string{
 				"Expected 3 replicas, but 2 are available"})),
-		"Labels":     component.NewLabels(objectLabels),
-		"Age":        component.NewTimestamp(now),
-		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "my_app")}),
-		"Status":     component.NewText("2/3"),
+		"Labels":   component.NewLabels(objectLabels),
+		"Age":      component.NewTimestamp(now),
+		"Selector": component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "my_app")}),
+		"Status": component.NewStatusBadges("2/3",
+			component.StatusBadge{Label: "Progressing", Status: component.TextStatusOK}),
 		"Containers": containers,
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			buildObjectDeleteAction(t, object),
@@ -116,6 +126,166 @@ func Test_DeploymentListHandler(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_DeploymentListHandler_statusThreshold(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+	require.NoError(t, printOptions.SetStatusThreshold(gvk.Deployment, ThresholdConfig{WarningBelow: 80, ErrorBelow: 50}))
+
+	now := testutil.Time()
+
+	object := testutil.CreateDeployment("deployment")
+	object.CreationTimestamp = metav1.Time{Time: now}
+	object.Spec.Replicas = conversion.PtrInt32(5)
+	object.Status = appsv1.DeploymentStatus{
+		Replicas:            5,
+		AvailableReplicas:   4,
+		UnavailableReplicas: 1,
+		Conditions: []appsv1.DeploymentCondition{
+			{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+		},
+	}
+
+	tpo.PathForObject(object, object.Name, "/path")
+
+	list := &appsv1.DeploymentList{
+		Items: []appsv1.Deployment{*object},
+	}
+
+	ctx := context.Background()
+	got, err := DeploymentListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
+	expected := component.NewTable("Deployments", "We couldn't find any deployments!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", "deployment", "/path",
+			genObjectStatus(component.TextStatusOK, []string{
+				"Expected 5 replicas, but 4 are available"})),
+		"Labels":   component.NewLabels(object.Labels),
+		"Age":      component.NewTimestamp(now),
+		"Selector": component.NewSelectors(nil),
+		"Status": component.NewStatusBadges("4/5",
+			component.StatusBadge{Label: "Available", Status: component.TextStatusOK}),
+		"Containers": component.NewContainers(),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, object),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_deploymentStatusBadges(t *testing.T) {
+	tests := []struct {
+		name        string
+		available   int32
+		unavailable int32
+		threshold   *ThresholdConfig
+		conditions  []appsv1.DeploymentCondition
+		expected    *component.StatusBadges
+	}{
+		{
+			name:        "no conditions",
+			available:   1,
+			unavailable: 2,
+			expected:    component.NewStatusBadges("1/3"),
+		},
+		{
+			name:      "available and progressing",
+			available: 3,
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+				{Type: appsv1.DeploymentReplicaFailure, Status: corev1.ConditionFalse},
+			},
+			expected: component.NewStatusBadges("3/3",
+				component.StatusBadge{Label: "Available", Status: component.TextStatusOK},
+				component.StatusBadge{Label: "Progressing", Status: component.TextStatusOK},
+			),
+		},
+		{
+			name:        "partially available",
+			available:   4,
+			unavailable: 1,
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+			},
+			expected: component.NewStatusBadges("4/5",
+				component.StatusBadge{Label: "Available", Status: component.TextStatusOK},
+			),
+		},
+		{
+			name:        "partially available with a threshold override",
+			available:   4,
+			unavailable: 1,
+			threshold:   &ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+			},
+			expected: component.NewStatusBadges("4/5",
+				component.StatusBadge{Label: "Available", Status: component.TextStatusOK},
+			),
+		},
+		{
+			name:        "partially available below a threshold override",
+			available:   2,
+			unavailable: 3,
+			threshold:   &ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+			},
+			expected: component.NewStatusBadges("2/5",
+				component.StatusBadge{Label: "Available", Status: component.TextStatusError},
+			),
+		},
+		{
+			name:        "replica failure",
+			available:   1,
+			unavailable: 2,
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Reason: "MinimumReplicasUnavailable"},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+				{Type: appsv1.DeploymentReplicaFailure, Status: corev1.ConditionTrue, Reason: "FailedCreate", Message: "exceeded quota"},
+			},
+			expected: component.NewStatusBadges("1/3",
+				component.StatusBadge{Label: "Not Available (MinimumReplicasUnavailable)", Status: component.TextStatusWarning},
+				component.StatusBadge{Label: "Not Progressing (ProgressDeadlineExceeded)", Status: component.TextStatusError},
+				component.StatusBadge{Label: "ReplicaFailure (FailedCreate)", Status: component.TextStatusError, Message: "exceeded quota"},
+			),
+		},
+		{
+			name:      "not available with all replicas available",
+			available: 1,
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Reason: "MinimumReplicasUnavailable"},
+			},
+			expected: component.NewStatusBadges("1/1",
+				component.StatusBadge{Label: "Not Available (MinimumReplicasUnavailable)", Status: component.TextStatusWarning},
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Status.AvailableReplicas = test.available
+			deployment.Status.UnavailableReplicas = test.unavailable
+			deployment.Status.Conditions = test.conditions
+
+			options := Options{}
+			if test.threshold != nil {
+				require.NoError(t, options.SetStatusThreshold(gvk.Deployment, *test.threshold))
+			}
+
+			got := deploymentStatusBadges(deployment, options)
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
 func Test_deploymentConfiguration(t *testing.T) {
 	var rhl int32 = 5
 	validDeployment := testutil.CreateDeployment("deployment")
@@ -167,6 +337,13 @@ func Test_deploymentConfiguration(t *testing.T) {
 			name:       "deployment",
 			deployment: validDeployment,
 			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{Header: "Name", Content: component.NewText("deployment")},
+				{Header: "Namespace", Content: component.NewText("namespace")},
+				{Header: "UID", Content: component.NewText("deployment")},
+				{Header: "Created", Content: component.NewText("<none>")},
+				{Header: "Generation", Content: component.NewText("<none>")},
+				{Header: "Labels", Content: component.NewText("<none>")},
+				{Header: "Annotations", Content: component.NewText("<none>")},
 				{
 					Header:  "Deployment Strategy",
 					Content: component.NewText("RollingUpdate"),
@@ -196,6 +373,10 @@ func Test_deploymentConfiguration(t *testing.T) {
 					Header:  "Replicas",
 					Content: component.NewText("3"),
 				},
+				{
+					Header:  "Pod Template Hash",
+					Content: component.NewText(podTemplateHash(validDeployment.Spec.Template)),
+				},
 			}...),
 		},
 		{
@@ -210,7 +391,7 @@ func Test_deploymentConfiguration(t *testing.T) {
 			dc := NewDeploymentConfiguration(tc.deployment)
 			dc.actionGenerators = []actionGeneratorFunction{}
 
-			summary, err := dc.Create()
+			summary, err := dc.Create(Options{})
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -223,26 +404,86 @@ func Test_deploymentConfiguration(t *testing.T) {
 }
 
 func Test_createDeploymentSummaryStatus(t *testing.T) {
-	deployment := testutil.CreateDeployment("deployment")
-	deployment.Status.AvailableReplicas = 1
-	deployment.Status.ReadyReplicas = 2
-	deployment.Status.Replicas = 3
-	deployment.Status.UnavailableReplicas = 4
-	deployment.Status.UpdatedReplicas = 5
-
-	got, err := createDeploymentSummaryStatus(deployment)
-	require.NoError(t, err)
+	available := func(s string, status component.TextStatus) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(status)
+		return text
+	}
 
-	sections := component.SummarySections{
-		{Header: "Available Replicas", Content: component.NewText("1")},
-		{Header: "Ready Replicas", Content: component.NewText("2")},
-		{Header: "Total Replicas", Content: component.NewText("3")},
-		{Header: "Unavailable Replicas", Content: component.NewText("4")},
-		{Header: "Updated Replicas", Content: component.NewText("5")},
+	tests := []struct {
+		name      string
+		available int32
+		desired   int32
+		threshold *ThresholdConfig
+		expected  *component.Text
+	}{
+		{
+			name:      "all replicas available",
+			available: 5,
+			desired:   5,
+			expected:  component.NewText("5"),
+		},
+		{
+			name:      "partially available",
+			available: 4,
+			desired:   5,
+			expected:  component.NewText("4"),
+		},
+		{
+			name:      "partially available with a threshold override",
+			available: 4,
+			desired:   5,
+			threshold: &ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+			expected:  available("4", component.TextStatusOK),
+		},
+		{
+			name:      "below a threshold override",
+			available: 2,
+			desired:   5,
+			threshold: &ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+			expected:  available("2", component.TextStatusError),
+		},
+		{
+			name:      "invalid threshold override is ignored",
+			available: 4,
+			desired:   5,
+			threshold: &ThresholdConfig{WarningBelow: 50, ErrorBelow: 80},
+			expected:  component.NewText("4"),
+		},
 	}
-	expected := component.NewSummary("Status", sections...)
 
-	assert.Equal(t, expected, got)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Spec.Replicas = conversion.PtrInt32(test.desired)
+			deployment.Status.AvailableReplicas = test.available
+			deployment.Status.ReadyReplicas = 2
+			deployment.Status.Replicas = 3
+			deployment.Status.UnavailableReplicas = 4
+			deployment.Status.UpdatedReplicas = 5
+
+			options := Options{}
+			if test.threshold != nil {
+				options.StatusThresholds = map[schema.GroupVersionKind]ThresholdConfig{
+					gvk.Deployment: *test.threshold,
+				}
+			}
+
+			got, err := createDeploymentSummaryStatus(deployment, options)
+			require.NoError(t, err)
+
+			sections := component.SummarySections{
+				{Header: "Available Replicas", Content: test.expected},
+				{Header: "Ready Replicas", Content: component.NewText("2")},
+				{Header: "Total Replicas", Content: component.NewText("3")},
+				{Header: "Unavailable Replicas", Content: component.NewText("4")},
+				{Header: "Updated Replicas", Content: component.NewText("5")},
+			}
+			expected := component.NewSummary("Status", sections...)
+
+			assert.Equal(t, expected, got)
+		})
+	}
 }
 
 func Test_createDeploymentConditionsView(t *testing.T) {
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// deviceClass contains the fields of a resource.k8s.io DeviceClass which are printed.
+type deviceClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec deviceClassSpec `json:"spec"`
+}
+
+type deviceClassSpec struct {
+	Selectors            []deviceSelector    `json:"selectors,omitempty"`
+	Config               []deviceClassConfig `json:"config,omitempty"`
+	ExtendedResourceName string              `json:"extendedResourceName,omitempty"`
+}
+
+type deviceClassConfig struct {
+	Opaque *struct {
+		Driver     string               `json:"driver"`
+		Parameters runtime.RawExtension `json:"parameters,omitempty"`
+	} `json:"opaque,omitempty"`
+}
+
+func toDeviceClass(cr *unstructured.Unstructured) (*deviceClass, error) {
+	if cr == nil {
+		return nil, errors.New("device class is nil")
+	}
+
+	dc := &deviceClass{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, dc); err != nil {
+		return nil, errors.Wrap(err, "convert device class")
+	}
+
+	return dc, nil
+}
+
+// DeviceClassHandler is a printFunc that prints a DeviceClass.
+func DeviceClassHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	dc, err := toDeviceClass(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewObject(cr)
+
+	o.RegisterConfig(createDeviceClassConfiguration(dc))
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createDeviceClassSelectorsView(dc), nil
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createDeviceClassConfigView(dc)
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+func createDeviceClassConfiguration(dc *deviceClass) *component.Summary {
+	sections := component.SummarySections{}
+
+	sections.AddText("Selectors", printDeviceSelectors(dc.Spec.Selectors))
+	if dc.Spec.ExtendedResourceName != "" {
+		sections.AddText("Extended Resource Name", dc.Spec.ExtendedResourceName)
+	}
+
+	return component.NewSummary("Configuration", sections...)
+}
+
+var (
+	deviceClassSelectorsCols = component.NewTableCols("Expression")
+	deviceClassConfigCols    = component.NewTableCols("Driver", "Parameters")
+)
+
+func createDeviceClassSelectorsView(dc *deviceClass) *component.Table {
+	table := component.NewTable("Selectors", "Device class selects all devices", deviceClassSelectorsCols)
+
+	for _, selector := range dc.Spec.Selectors {
+		if selector.CEL == nil {
+			continue
+		}
+
+		table.Add(component.TableRow{
+			"Expression": component.NewCodeBlock(selector.CEL.Expression),
+		})
+	}
+
+	return table
+}
+
+func createDeviceClassConfigView(dc *deviceClass) (*component.Table, error) {
+	table := component.NewTable("Config", "There is no device configuration!", deviceClassConfigCols)
+
+	for _, config := range dc.Spec.Config {
+		if config.Opaque == nil {
+			continue
+		}
+
+		parameters := "<none>"
+		if len(config.Opaque.Parameters.Raw) > 0 {
+			var v interface{}
+			if err := json.Unmarshal(config.Opaque.Parameters.Raw, &v); err != nil {
+				return nil, errors.Wrap(err, "decode device class config parameters")
+			}
+			data, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			parameters = string(data)
+		}
+
+		table.Add(component.TableRow{
+			"Driver":     component.NewText(config.Opaque.Driver),
+			"Parameters": component.NewCodeBlock(parameters),
+		})
+	}
+
+	return table, nil
+}
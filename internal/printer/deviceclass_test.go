@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createDeviceClassConfiguration(t *testing.T) {
+	dc, err := toDeviceClass(testutil.LoadUnstructuredFromFile(t, "deviceclass.yaml"))
+	require.NoError(t, err)
+
+	got := createDeviceClassConfiguration(dc)
+
+	expected := component.NewSummary("Configuration", component.SummarySections{
+		{Header: "Selectors", Content: component.NewText(`device.driver == "gpu.example.com"`)},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createDeviceClassSelectorsView(t *testing.T) {
+	dc, err := toDeviceClass(testutil.LoadUnstructuredFromFile(t, "deviceclass.yaml"))
+	require.NoError(t, err)
+
+	got := createDeviceClassSelectorsView(dc)
+
+	expected := component.NewTable("Selectors", "Device class selects all devices", deviceClassSelectorsCols)
+	expected.Add(component.TableRow{
+		"Expression": component.NewCodeBlock(`device.driver == "gpu.example.com"`),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createDeviceClassConfigView(t *testing.T) {
+	dc, err := toDeviceClass(testutil.LoadUnstructuredFromFile(t, "deviceclass.yaml"))
+	require.NoError(t, err)
+
+	got, err := createDeviceClassConfigView(dc)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Config", "There is no device configuration!", deviceClassConfigCols)
+	expected.Add(component.TableRow{
+		"Driver":     component.NewText("gpu.example.com"),
+		"Parameters": component.NewCodeBlock("{\n  \"sharing\": \"time-slicing\"\n}"),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
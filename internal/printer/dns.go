@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// defaultClusterDomain is the cluster domain kubelet uses unless configured otherwise.
+	defaultClusterDomain = "cluster.local"
+	// defaultClusterFirstNdots is the ndots option kubelet sets for pods using cluster DNS.
+	defaultClusterFirstNdots = "5"
+
+	clusterDNSNameserver = "<cluster DNS service>"
+	nodeDNSValue         = "<inherited from node>"
+	customDNSSuffix      = " (dnsConfig)"
+)
+
+// printPodDNSConfig prints the DNS configuration a pod's resolver is expected to use. It
+// combines the pod's dnsConfig with the defaults kubelet provides for the pod's DNS policy.
+// Values provided by the pod's dnsConfig are marked.
+func printPodDNSConfig(pod *corev1.Pod) (*component.Summary, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	spec := pod.Spec
+
+	policy := spec.DNSPolicy
+	if policy == "" {
+		policy = corev1.DNSClusterFirst
+	}
+
+	effectivePolicy := policy
+	if policy == corev1.DNSClusterFirst && spec.HostNetwork {
+		// kubelet falls back to the node's resolver for host network pods
+		// unless they use ClusterFirstWithHostNet.
+		effectivePolicy = corev1.DNSDefault
+	}
+
+	var nameservers, searches []string
+	var options []dnsOption
+
+	setOption := func(option dnsOption) {
+		for i := range options {
+			if options[i].name == option.name {
+				options[i] = option
+				return
+			}
+		}
+		options = append(options, option)
+	}
+
+	switch effectivePolicy {
+	case corev1.DNSClusterFirst, corev1.DNSClusterFirstWithHostNet:
+		nameservers = append(nameservers, clusterDNSNameserver)
+		searches = append(searches,
+			fmt.Sprintf("%s.svc.%s", pod.Namespace, defaultClusterDomain),
+			fmt.Sprintf("svc.%s", defaultClusterDomain),
+			defaultClusterDomain)
+		setOption(dnsOption{name: "ndots", value: defaultClusterFirstNdots})
+	case corev1.DNSDefault:
+		nameservers = append(nameservers, nodeDNSValue)
+		searches = append(searches, nodeDNSValue)
+	}
+
+	dnsConfig := spec.DNSConfig
+	if dnsConfig != nil {
+		for _, nameserver := range dnsConfig.Nameservers {
+			nameservers = append(nameservers, nameserver+customDNSSuffix)
+		}
+		for _, search := range dnsConfig.Searches {
+			searches = append(searches, search+customDNSSuffix)
+		}
+		for _, option := range dnsConfig.Options {
+			value := ""
+			if option.Value != nil {
+				value = *option.Value
+			}
+			setOption(dnsOption{name: option.Name, value: value, custom: true})
+		}
+	}
+
+	sections := component.SummarySections{}
+
+	policyText := string(policy)
+	if effectivePolicy != policy {
+		policyText = fmt.Sprintf("%s (%s with host network)", policy, effectivePolicy)
+	}
+	sections.AddText("Policy", policyText)
+
+	if effectivePolicy == corev1.DNSClusterFirst || effectivePolicy == corev1.DNSClusterFirstWithHostNet {
+		sections.AddText("Cluster Domain", fmt.Sprintf("%s (expected)", defaultClusterDomain))
+	}
+
+	sections.AddText("Nameservers", dnsList(nameservers))
+	sections.AddText("Searches", dnsList(searches))
+
+	var optionStrings []string
+	for _, option := range options {
+		optionStrings = append(optionStrings, option.String())
+	}
+	sections.AddText("Options", dnsList(optionStrings))
+
+	summary := component.NewSummary("DNS", sections...)
+
+	if dnsConfig != nil {
+		summary.SetAlert(component.NewAlert(component.AlertTypeInfo,
+			"Pod dnsConfig overrides the default DNS configuration"))
+	}
+
+	return summary, nil
+}
+
+func dnsList(values []string) string {
+	if len(values) == 0 {
+		return "<none>"
+	}
+	return strings.Join(values, ", ")
+}
+
+// dnsOption is a resolver option. Custom options are set by the pod's dnsConfig.
+type dnsOption struct {
+	name   string
+	value  string
+	custom bool
+}
+
+func (o dnsOption) String() string {
+	s := o.name
+	if o.value != "" {
+		s = fmt.Sprintf("%s:%s", o.name, o.value)
+	}
+	if o.custom {
+		s += customDNSSuffix
+	}
+	return s
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_printPodDNSConfig(t *testing.T) {
+	ndots := "2"
+
+	tests := []struct {
+		name     string
+		pod      *corev1.Pod
+		expected *component.Summary
+		isErr    bool
+	}{
+		{
+			name: "cluster first",
+			pod:  testutil.CreatePod("pod"),
+			expected: component.NewSummary("DNS", []component.SummarySection{
+				{Header: "Policy", Content: component.NewText("ClusterFirst")},
+				{Header: "Cluster Domain", Content: component.NewText("cluster.local (expected)")},
+				{Header: "Nameservers", Content: component.NewText("<cluster DNS service>")},
+				{Header: "Searches", Content: component.NewText("namespace.svc.cluster.local, svc.cluster.local, cluster.local")},
+				{Header: "Options", Content: component.NewText("ndots:5")},
+			}...),
+		},
+		{
+			name: "cluster first with host network",
+			pod: testutil.CreatePod("pod", func(pod *corev1.Pod) {
+				pod.Spec.HostNetwork = true
+			}),
+			expected: component.NewSummary("DNS", []component.SummarySection{
+				{Header: "Policy", Content: component.NewText("ClusterFirst (Default with host network)")},
+				{Header: "Nameservers", Content: component.NewText("<inherited from node>")},
+				{Header: "Searches", Content: component.NewText("<inherited from node>")},
+				{Header: "Options", Content: component.NewText("<none>")},
+			}...),
+		},
+		{
+			name: "custom dns config",
+			pod: testutil.CreatePod("pod", func(pod *corev1.Pod) {
+				pod.Spec.DNSPolicy = corev1.DNSClusterFirst
+				pod.Spec.DNSConfig = &corev1.PodDNSConfig{
+					Nameservers: []string{"1.1.1.1"},
+					Searches:    []string{"example.com"},
+					Options: []corev1.PodDNSConfigOption{
+						{Name: "ndots", Value: &ndots},
+						{Name: "edns0"},
+					},
+				}
+			}),
+			expected: func() *component.Summary {
+				summary := component.NewSummary("DNS", []component.SummarySection{
+					{Header: "Policy", Content: component.NewText("ClusterFirst")},
+					{Header: "Cluster Domain", Content: component.NewText("cluster.local (expected)")},
+					{Header: "Nameservers", Content: component.NewText("<cluster DNS service>, 1.1.1.1 (dnsConfig)")},
+					{Header: "Searches", Content: component.NewText("namespace.svc.cluster.local, svc.cluster.local, cluster.local, example.com (dnsConfig)")},
+					{Header: "Options", Content: component.NewText("ndots:2 (dnsConfig), edns0 (dnsConfig)")},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeInfo, "Pod dnsConfig overrides the default DNS configuration"))
+				return summary
+			}(),
+		},
+		{
+			name: "none",
+			pod: testutil.CreatePod("pod", func(pod *corev1.Pod) {
+				pod.Spec.DNSPolicy = corev1.DNSNone
+				pod.Spec.DNSConfig = &corev1.PodDNSConfig{
+					Nameservers: []string{"10.0.0.2"},
+				}
+			}),
+			expected: func() *component.Summary {
+				summary := component.NewSummary("DNS", []component.SummarySection{
+					{Header: "Policy", Content: component.NewText("None")},
+					{Header: "Nameservers", Content: component.NewText("10.0.0.2 (dnsConfig)")},
+					{Header: "Searches", Content: component.NewText("<none>")},
+					{Header: "Options", Content: component.NewText("<none>")},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeInfo, "Pod dnsConfig overrides the default DNS configuration"))
+				return summary
+			}(),
+		},
+		{
+			name:  "nil pod",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := printPodDNSConfig(test.pod)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
@@ -36,9 +36,14 @@ func EventListHandler(ctx context.Context, list *corev1.EventList, opts Options)
 	cols := component.NewTableCols("Kind", "Message", "Reason", "Type",
 		"First Seen", "Last Seen")
 	table := component.NewTable("Events", "We couldn't find any events!", cols)
+	ageFilter := newAgeFilter(opts)
+	ageFilter.addBucketFilter(table)
 
 	for _, event := range list.Items {
 		row := component.TableRow{}
+		if !ageFilter.filterRow(event.CreationTimestamp.Time, row) {
+			continue
+		}
 
 		objectPath, err := ObjectReferencePath(event.InvolvedObject)
 		if err != nil {
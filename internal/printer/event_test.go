@@ -16,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware-tanzu/octant/pkg/store"
 	storefake "github.com/vmware-tanzu/octant/pkg/store/fake"
@@ -381,3 +382,43 @@ func Test_eventsForObject(t *testing.T) {
 
 	assert.Equal(t, expected.Items, got.Items)
 }
+
+func Test_EventListHandler_ageFilter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	now := time.Unix(1548424420, 0)
+
+	newEvent := func(name string, age time.Duration) corev1.Event {
+		return corev1.Event{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Message: name,
+		}
+	}
+
+	list := &corev1.EventList{
+		Items: []corev1.Event{
+			newEvent("recent", 10*time.Minute),
+			newEvent("old", 30*24*time.Hour),
+		},
+	}
+
+	tpo.PathForObject(&list.Items[0], "recent", "/recent")
+
+	printOptions := tpo.ToOptions()
+	printOptions.MaxAge = 7 * 24 * time.Hour
+	printOptions.AgeBuckets = true
+	printOptions.Clock = clock.NewFakeClock(now)
+
+	got, err := EventListHandler(context.Background(), list, printOptions)
+	require.NoError(t, err)
+
+	assertAgeBuckets(t, got, []string{ageBucketHour})
+}
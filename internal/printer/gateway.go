@@ -0,0 +1,592 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	gatewayGroup = "gateway.networking.k8s.io"
+
+	gatewayConditionAccepted   = "Accepted"
+	gatewayConditionProgrammed = "Programmed"
+)
+
+// gateway contains the fields of a gateway.networking.k8s.io Gateway which are printed.
+// Gateway API types are not part of the Kubernetes API, so they are extracted from the
+// unstructured custom resource.
+type gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   gatewaySpec   `json:"spec"`
+	Status gatewayStatus `json:"status,omitempty"`
+}
+
+type gatewaySpec struct {
+	GatewayClassName string            `json:"gatewayClassName"`
+	Listeners        []gatewayListener `json:"listeners,omitempty"`
+	Addresses        []gatewayAddress  `json:"addresses,omitempty"`
+}
+
+type gatewayListener struct {
+	Name     string  `json:"name"`
+	Hostname *string `json:"hostname,omitempty"`
+	Port     int32   `json:"port"`
+	Protocol string  `json:"protocol"`
+}
+
+type gatewayAddress struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+type gatewayStatus struct {
+	Addresses  []gatewayAddress          `json:"addresses,omitempty"`
+	Conditions []customResourceCondition `json:"conditions,omitempty"`
+	Listeners  []gatewayListenerStatus   `json:"listeners,omitempty"`
+}
+
+type gatewayListenerStatus struct {
+	Name           string                    `json:"name"`
+	AttachedRoutes int32                     `json:"attachedRoutes"`
+	Conditions     []customResourceCondition `json:"conditions,omitempty"`
+}
+
+// httpRoute contains the fields of a gateway.networking.k8s.io HTTPRoute which are printed.
+type httpRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   httpRouteSpec   `json:"spec"`
+	Status httpRouteStatus `json:"status,omitempty"`
+}
+
+type httpRouteSpec struct {
+	ParentRefs []gatewayParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string                 `json:"hostnames,omitempty"`
+	Rules      []httpRouteRule          `json:"rules,omitempty"`
+}
+
+type gatewayParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type httpRouteRule struct {
+	Matches     []httpRouteMatch `json:"matches,omitempty"`
+	BackendRefs []httpBackendRef `json:"backendRefs,omitempty"`
+}
+
+type httpRouteMatch struct {
+	Path        *httpPathMatch   `json:"path,omitempty"`
+	Headers     []httpValueMatch `json:"headers,omitempty"`
+	QueryParams []httpValueMatch `json:"queryParams,omitempty"`
+	Method      *string          `json:"method,omitempty"`
+}
+
+type httpPathMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type httpValueMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Name  string  `json:"name"`
+	Value string  `json:"value"`
+}
+
+type httpBackendRef struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+	Weight    *int32  `json:"weight,omitempty"`
+}
+
+type httpRouteStatus struct {
+	Parents []routeParentStatus `json:"parents,omitempty"`
+}
+
+type routeParentStatus struct {
+	ParentRef      gatewayParentReference    `json:"parentRef"`
+	ControllerName string                    `json:"controllerName"`
+	Conditions     []customResourceCondition `json:"conditions,omitempty"`
+}
+
+func stringOrDefault(s *string, defaultValue string) string {
+	if s == nil || *s == "" {
+		return defaultValue
+	}
+	return *s
+}
+
+func toGateway(cr *unstructured.Unstructured) (*gateway, error) {
+	if cr == nil {
+		return nil, errors.New("gateway is nil")
+	}
+
+	g := &gateway{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, g); err != nil {
+		return nil, errors.Wrap(err, "convert gateway")
+	}
+
+	return g, nil
+}
+
+func toHTTPRoute(cr *unstructured.Unstructured) (*httpRoute, error) {
+	if cr == nil {
+		return nil, errors.New("http route is nil")
+	}
+
+	route := &httpRoute{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, route); err != nil {
+		return nil, errors.Wrap(err, "convert http route")
+	}
+
+	return route, nil
+}
+
+// GatewayHandler is a printFunc that prints a Gateway.
+func GatewayHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	g, err := toGateway(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewObject(cr)
+	o.EnableEvents()
+
+	o.RegisterConfig(createGatewayConfiguration(g))
+	o.RegisterSummary(createGatewayStatus(g))
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createGatewayListenersView(g), nil
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createGatewayAttachedRoutesView(ctx, g, options)
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createCustomResourceConditionsView(g.Status.Conditions), nil
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+func createGatewayConfiguration(g *gateway) *component.Summary {
+	sections := component.SummarySections{}
+
+	sections.AddText("Gateway Class", g.Spec.GatewayClassName)
+	if len(g.Spec.Addresses) > 0 {
+		sections.AddText("Requested Addresses", printGatewayAddresses(g.Spec.Addresses))
+	}
+
+	return component.NewSummary("Configuration", sections...)
+}
+
+func createGatewayStatus(g *gateway) *component.Summary {
+	sections := component.SummarySections{}
+
+	addresses := "<none>"
+	if len(g.Status.Addresses) > 0 {
+		addresses = printGatewayAddresses(g.Status.Addresses)
+	}
+	sections.AddText("Addresses", addresses)
+
+	summary := component.NewSummary("Status")
+
+	var problems []string
+	for _, conditionType := range []string{gatewayConditionAccepted, gatewayConditionProgrammed} {
+		text, problem := gatewayConditionStatus(g.Status.Conditions, conditionType)
+		sections.Add(conditionType, text)
+		if problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeError,
+			fmt.Sprintf("Gateway is not ready: %s", strings.Join(problems, "; "))))
+	}
+
+	summary.Add(sections...)
+
+	return summary
+}
+
+// gatewayConditionStatus prints the status of a Gateway API condition. Conditions with a False
+// status are flagged as errors and a description of the problem is returned.
+func gatewayConditionStatus(conditions []customResourceCondition, conditionType string) (*component.Text, string) {
+	condition, ok := findCustomResourceCondition(conditions, conditionType)
+	if !ok {
+		return component.NewText(string(corev1.ConditionUnknown)), ""
+	}
+
+	if condition.Status != corev1.ConditionFalse {
+		return component.NewText(string(condition.Status)), ""
+	}
+
+	problem := fmt.Sprintf("not %s", strings.ToLower(conditionType))
+	if condition.Reason != "" {
+		problem = fmt.Sprintf("%s (%s)", problem, condition.Reason)
+	}
+	if condition.Message != "" {
+		problem = fmt.Sprintf("%s: %s", problem, condition.Message)
+	}
+
+	text := component.NewText(string(condition.Status))
+	if condition.Reason != "" {
+		text = component.NewTextf("%s (%s)", condition.Status, condition.Reason)
+	}
+	text.SetStatus(component.TextStatusError)
+
+	return text, problem
+}
+
+func printGatewayAddresses(addresses []gatewayAddress) string {
+	var list []string
+	for _, address := range addresses {
+		list = append(list, fmt.Sprintf("%s (%s)", address.Value, stringOrDefault(address.Type, "IPAddress")))
+	}
+	return strings.Join(list, ", ")
+}
+
+var (
+	gatewayListenersCols = component.NewTableCols("Name", "Hostname", "Port", "Protocol", "Attached Routes", "Accepted", "Programmed")
+)
+
+func createGatewayListenersView(g *gateway) *component.Table {
+	table := component.NewTable("Listeners", "There are no listeners!", gatewayListenersCols)
+
+	listenerStatuses := make(map[string]gatewayListenerStatus)
+	for _, listenerStatus := range g.Status.Listeners {
+		listenerStatuses[listenerStatus.Name] = listenerStatus
+	}
+
+	for _, listener := range g.Spec.Listeners {
+		listenerStatus := listenerStatuses[listener.Name]
+
+		accepted, _ := gatewayConditionStatus(listenerStatus.Conditions, gatewayConditionAccepted)
+		programmed, _ := gatewayConditionStatus(listenerStatus.Conditions, gatewayConditionProgrammed)
+
+		table.Add(component.TableRow{
+			"Name":            component.NewText(listener.Name),
+			"Hostname":        component.NewText(stringOrDefault(listener.Hostname, "*")),
+			"Port":            component.NewTextf("%d", listener.Port),
+			"Protocol":        component.NewText(listener.Protocol),
+			"Attached Routes": component.NewTextf("%d", listenerStatus.AttachedRoutes),
+			"Accepted":        accepted,
+			"Programmed":      programmed,
+		})
+	}
+
+	return table
+}
+
+var (
+	gatewayAttachedRoutesCols = component.NewTableCols("Name", "Hostnames", "Section")
+)
+
+// createGatewayAttachedRoutesView lists the HTTPRoutes in every namespace which reference
+// the gateway as a parent. If HTTPRoutes can't be listed, e.g. because their CRD isn't
+// installed, the table is empty and says so.
+func createGatewayAttachedRoutesView(ctx context.Context, g *gateway, options Options) (*component.Table, error) {
+	key := store.Key{
+		APIVersion: g.APIVersion,
+		Kind:       "HTTPRoute",
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		log.From(ctx).Errorf("list http routes for gateway %s: %s", g.Name, err)
+		return component.NewTable("Attached Routes", "Unable to list HTTP routes", gatewayAttachedRoutesCols), nil
+	}
+
+	table := component.NewTable("Attached Routes", "There are no attached routes!", gatewayAttachedRoutesCols)
+
+	for i := range list.Items {
+		route, err := toHTTPRoute(&list.Items[i])
+		if err != nil {
+			log.From(ctx).Errorf("convert http route %s: %s", list.Items[i].GetName(), err)
+			continue
+		}
+
+		for _, parentRef := range route.Spec.ParentRefs {
+			if !parentRef.references(route.Namespace, g) {
+				continue
+			}
+
+			nameLink, err := options.Link.ForGVK(route.Namespace, route.APIVersion, "HTTPRoute", route.Name, route.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			hostnames := "*"
+			if len(route.Spec.Hostnames) > 0 {
+				hostnames = strings.Join(route.Spec.Hostnames, ", ")
+			}
+
+			table.Add(component.TableRow{
+				"Name":      nameLink,
+				"Hostnames": component.NewText(hostnames),
+				"Section":   component.NewText(stringOrDefault(parentRef.SectionName, "*")),
+			})
+		}
+	}
+
+	return table, nil
+}
+
+// references returns true if the parent reference, which is declared by a route in
+// routeNamespace, references a gateway.
+func (r gatewayParentReference) references(routeNamespace string, g *gateway) bool {
+	return stringOrDefault(r.Group, gatewayGroup) == gatewayGroup &&
+		stringOrDefault(r.Kind, "Gateway") == "Gateway" &&
+		stringOrDefault(r.Namespace, routeNamespace) == g.Namespace &&
+		r.Name == g.Name
+}
+
+// HTTPRouteHandler is a printFunc that prints an HTTPRoute.
+func HTTPRouteHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	route, err := toHTTPRoute(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewObject(cr)
+	o.EnableEvents()
+
+	config, err := createHTTPRouteConfiguration(route, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print httproute configuration")
+	}
+	o.RegisterConfig(config)
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createHTTPRouteRulesView(route, options)
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createHTTPRouteParentsView(route, options)
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+func createHTTPRouteConfiguration(route *httpRoute, options Options) (*component.Summary, error) {
+	sections := component.SummarySections{}
+
+	hostnames := "*"
+	if len(route.Spec.Hostnames) > 0 {
+		hostnames = strings.Join(route.Spec.Hostnames, ", ")
+	}
+	sections.AddText("Hostnames", hostnames)
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	var problems []string
+	for _, parentStatus := range route.Status.Parents {
+		if _, problem := gatewayConditionStatus(parentStatus.Conditions, gatewayConditionAccepted); problem != "" {
+			problems = append(problems, fmt.Sprintf("%s %s", parentStatus.ParentRef.Name, problem))
+		}
+	}
+
+	if len(problems) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeError,
+			fmt.Sprintf("Route is not accepted by all parents: %s", strings.Join(problems, "; "))))
+	}
+
+	return summary, nil
+}
+
+// link creates a link to a route's parent. Parents which are not Gateways are printed as text.
+func (r gatewayParentReference) link(route *httpRoute, options Options) (component.Component, error) {
+	namespace := stringOrDefault(r.Namespace, route.Namespace)
+	kind := stringOrDefault(r.Kind, "Gateway")
+	group := stringOrDefault(r.Group, gatewayGroup)
+
+	if group != gatewayGroup || kind != "Gateway" {
+		return component.NewTextf("%s/%s", kind, r.Name), nil
+	}
+
+	apiVersion := schema.GroupVersion{
+		Group:   gatewayGroup,
+		Version: route.GroupVersionKind().Version,
+	}.String()
+
+	return options.Link.ForGVK(namespace, apiVersion, kind, r.Name, r.Name)
+}
+
+var (
+	httpRouteParentsCols = component.NewTableCols("Parent", "Section", "Controller", "Accepted")
+)
+
+func createHTTPRouteParentsView(route *httpRoute, options Options) (*component.Table, error) {
+	table := component.NewTable("Parents", "There are no parents!", httpRouteParentsCols)
+
+	parentStatuses := make(map[string]routeParentStatus)
+	for _, parentStatus := range route.Status.Parents {
+		parentStatuses[parentStatus.ParentRef.key(route.Namespace)] = parentStatus
+	}
+
+	for _, parentRef := range route.Spec.ParentRefs {
+		parent, err := parentRef.link(route, options)
+		if err != nil {
+			return nil, err
+		}
+
+		parentStatus := parentStatuses[parentRef.key(route.Namespace)]
+		accepted, _ := gatewayConditionStatus(parentStatus.Conditions, gatewayConditionAccepted)
+
+		table.Add(component.TableRow{
+			"Parent":     parent,
+			"Section":    component.NewText(stringOrDefault(parentRef.SectionName, "*")),
+			"Controller": component.NewText(parentStatus.ControllerName),
+			"Accepted":   accepted,
+		})
+	}
+
+	return table, nil
+}
+
+func (r gatewayParentReference) key(routeNamespace string) string {
+	return strings.Join([]string{
+		stringOrDefault(r.Group, gatewayGroup),
+		stringOrDefault(r.Kind, "Gateway"),
+		stringOrDefault(r.Namespace, routeNamespace),
+		r.Name,
+		stringOrDefault(r.SectionName, ""),
+	}, "/")
+}
+
+var (
+	httpRouteRulesCols = component.NewTableCols("Rule", "Matches", "Backend", "Weight")
+)
+
+func createHTTPRouteRulesView(route *httpRoute, options Options) (*component.Table, error) {
+	table := component.NewTable("Rules", "There are no rules!", httpRouteRulesCols)
+
+	for i, rule := range route.Spec.Rules {
+		matches := printHTTPRouteMatches(rule.Matches)
+
+		if len(rule.BackendRefs) == 0 {
+			table.Add(component.TableRow{
+				"Rule":    component.NewTextf("%d", i+1),
+				"Matches": component.NewText(matches),
+				"Backend": component.NewText("<none>"),
+				"Weight":  component.NewText(""),
+			})
+			continue
+		}
+
+		for _, backendRef := range rule.BackendRefs {
+			backend, err := httpBackendRefLink(route, backendRef, options)
+			if err != nil {
+				return nil, err
+			}
+
+			weight := "1"
+			if backendRef.Weight != nil {
+				weight = fmt.Sprintf("%d", *backendRef.Weight)
+			}
+
+			table.Add(component.TableRow{
+				"Rule":    component.NewTextf("%d", i+1),
+				"Matches": component.NewText(matches),
+				"Backend": backend,
+				"Weight":  component.NewText(weight),
+			})
+		}
+	}
+
+	return table, nil
+}
+
+// httpBackendRefLink creates a link to a backend. Backends which are not Services are
+// printed as text since their version is not known.
+func httpBackendRefLink(route *httpRoute, backendRef httpBackendRef, options Options) (component.Component, error) {
+	text := backendRef.Name
+	if backendRef.Port != nil {
+		text = fmt.Sprintf("%s:%d", backendRef.Name, *backendRef.Port)
+	}
+
+	kind := stringOrDefault(backendRef.Kind, "Service")
+	if stringOrDefault(backendRef.Group, "") != "" || kind != "Service" {
+		return component.NewTextf("%s/%s", kind, text), nil
+	}
+
+	namespace := stringOrDefault(backendRef.Namespace, route.Namespace)
+	return options.Link.ForGVK(namespace, "v1", "Service", backendRef.Name, text)
+}
+
+func printHTTPRouteMatches(matches []httpRouteMatch) string {
+	if len(matches) == 0 {
+		return "PathPrefix /"
+	}
+
+	var list []string
+	for _, match := range matches {
+		var parts []string
+
+		if match.Path != nil {
+			parts = append(parts, fmt.Sprintf("%s %s",
+				stringOrDefault(match.Path.Type, "PathPrefix"), stringOrDefault(match.Path.Value, "/")))
+		}
+		if match.Method != nil {
+			parts = append(parts, *match.Method)
+		}
+		for _, header := range match.Headers {
+			parts = append(parts, fmt.Sprintf("header %s=%s", header.Name, header.Value))
+		}
+		for _, queryParam := range match.QueryParams {
+			parts = append(parts, fmt.Sprintf("query %s=%s", queryParam.Name, queryParam.Value))
+		}
+
+		if len(parts) == 0 {
+			parts = append(parts, "PathPrefix /")
+		}
+
+		list = append(list, strings.Join(parts, ", "))
+	}
+
+	return strings.Join(list, "; ")
+}
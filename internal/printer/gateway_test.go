@@ -0,0 +1,239 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createGatewayStatus(t *testing.T) {
+	g, err := toGateway(testutil.LoadUnstructuredFromFile(t, "gateway.yaml"))
+	require.NoError(t, err)
+
+	got := createGatewayStatus(g)
+
+	programmed := component.NewText("False (AddressNotAssigned)")
+	programmed.SetStatus(component.TextStatusError)
+
+	expected := component.NewSummary("Status", component.SummarySections{
+		{Header: "Addresses", Content: component.NewText("10.0.0.1 (IPAddress)")},
+		{Header: "Accepted", Content: component.NewText("True")},
+		{Header: "Programmed", Content: programmed},
+	}...)
+	expected.SetAlert(component.NewAlert(component.AlertTypeError,
+		"Gateway is not ready: not programmed (AddressNotAssigned): No addresses have been assigned"))
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createGatewayListenersView(t *testing.T) {
+	g, err := toGateway(testutil.LoadUnstructuredFromFile(t, "gateway.yaml"))
+	require.NoError(t, err)
+
+	got := createGatewayListenersView(g)
+
+	expected := component.NewTable("Listeners", "There are no listeners!", gatewayListenersCols)
+	expected.Add(
+		component.TableRow{
+			"Name":            component.NewText("http"),
+			"Hostname":        component.NewText("*"),
+			"Port":            component.NewText("80"),
+			"Protocol":        component.NewText("HTTP"),
+			"Attached Routes": component.NewText("1"),
+			"Accepted":        component.NewText("True"),
+			"Programmed":      component.NewText("True"),
+		},
+		component.TableRow{
+			"Name":            component.NewText("https"),
+			"Hostname":        component.NewText("*.example.com"),
+			"Port":            component.NewText("443"),
+			"Protocol":        component.NewText("HTTPS"),
+			"Attached Routes": component.NewText("0"),
+			"Accepted":        component.NewText("Unknown"),
+			"Programmed":      component.NewText("Unknown"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createGatewayAttachedRoutesView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	g, err := toGateway(testutil.LoadUnstructuredFromFile(t, "gateway.yaml"))
+	require.NoError(t, err)
+
+	attached := testutil.LoadUnstructuredFromFile(t, "httproute.yaml")
+	other := attached.DeepCopy()
+	other.SetName("other")
+	require.NoError(t, unstructured.SetNestedSlice(other.Object, []interface{}{
+		map[string]interface{}{"name": "other-gateway"},
+	}, "spec", "parentRefs"))
+
+	crossNamespace := attached.DeepCopy()
+	crossNamespace.SetName("cross-namespace")
+	crossNamespace.SetNamespace("team")
+	require.NoError(t, unstructured.SetNestedSlice(crossNamespace.Object, []interface{}{
+		map[string]interface{}{"name": g.Name, "namespace": g.Namespace},
+	}, "spec", "parentRefs"))
+
+	key := store.Key{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*attached, *other, *crossNamespace}}, false, nil)
+
+	tpo.PathForGVK("default", "gateway.networking.k8s.io/v1", "HTTPRoute", "route", "route", "/route")
+	tpo.PathForGVK("team", "gateway.networking.k8s.io/v1", "HTTPRoute", "cross-namespace", "cross-namespace", "/cross-namespace")
+
+	got, err := createGatewayAttachedRoutesView(context.Background(), g, printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Attached Routes", "There are no attached routes!", gatewayAttachedRoutesCols)
+	expected.Add(
+		component.TableRow{
+			"Name":      component.NewLink("", "route", "/route"),
+			"Hostnames": component.NewText("www.example.com"),
+			"Section":   component.NewText("http"),
+		},
+		component.TableRow{
+			"Name":      component.NewLink("", "cross-namespace", "/cross-namespace"),
+			"Hostnames": component.NewText("www.example.com"),
+			"Section":   component.NewText("*"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createGatewayAttachedRoutesView_listError(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	g, err := toGateway(testutil.LoadUnstructuredFromFile(t, "gateway.yaml"))
+	require.NoError(t, err)
+
+	key := store.Key{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(nil, false, errors.New("no matches for kind HTTPRoute"))
+
+	got, err := createGatewayAttachedRoutesView(context.Background(), g, printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Attached Routes", "Unable to list HTTP routes", gatewayAttachedRoutesCols)
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createHTTPRouteConfiguration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	route, err := toHTTPRoute(testutil.LoadUnstructuredFromFile(t, "httproute.yaml"))
+	require.NoError(t, err)
+
+	got, err := createHTTPRouteConfiguration(route, printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Configuration", component.SummarySections{
+		{Header: "Hostnames", Content: component.NewText("www.example.com")},
+	}...)
+	expected.SetAlert(component.NewAlert(component.AlertTypeError,
+		"Route is not accepted by all parents: gateway not accepted (NotAllowedByListeners)"))
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createHTTPRouteParentsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("default", "gateway.networking.k8s.io/v1", "Gateway", "gateway", "gateway", "/gateway")
+
+	route, err := toHTTPRoute(testutil.LoadUnstructuredFromFile(t, "httproute.yaml"))
+	require.NoError(t, err)
+
+	got, err := createHTTPRouteParentsView(route, printOptions)
+	require.NoError(t, err)
+
+	accepted := component.NewText("False (NotAllowedByListeners)")
+	accepted.SetStatus(component.TextStatusError)
+
+	expected := component.NewTable("Parents", "There are no parents!", httpRouteParentsCols)
+	expected.Add(component.TableRow{
+		"Parent":     component.NewLink("", "gateway", "/gateway"),
+		"Section":    component.NewText("http"),
+		"Controller": component.NewText("example.com/gateway-controller"),
+		"Accepted":   accepted,
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createHTTPRouteRulesView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("default", "v1", "Service", "api", "api:8080", "/api")
+	tpo.PathForGVK("default", "v1", "Service", "api-canary", "api-canary:8080", "/api-canary")
+
+	route, err := toHTTPRoute(testutil.LoadUnstructuredFromFile(t, "httproute.yaml"))
+	require.NoError(t, err)
+
+	got, err := createHTTPRouteRulesView(route, printOptions)
+	require.NoError(t, err)
+
+	matches := component.NewText("PathPrefix /api, GET, header x-env=canary")
+
+	expected := component.NewTable("Rules", "There are no rules!", httpRouteRulesCols)
+	expected.Add(
+		component.TableRow{
+			"Rule":    component.NewText("1"),
+			"Matches": matches,
+			"Backend": component.NewLink("", "api:8080", "/api"),
+			"Weight":  component.NewText("90"),
+		},
+		component.TableRow{
+			"Rule":    component.NewText("1"),
+			"Matches": matches,
+			"Backend": component.NewLink("", "api-canary:8080", "/api-canary"),
+			"Weight":  component.NewText("10"),
+		},
+		component.TableRow{
+			"Rule":    component.NewText("2"),
+			"Matches": component.NewText("PathPrefix /"),
+			"Backend": component.NewText("Bucket/static"),
+			"Weight":  component.NewText("1"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
@@ -5,6 +5,17 @@ SPDX-License-Identifier: Apache-2.0
 
 package printer
 
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
 // Handler configures handlers for a printer.
 type Handler interface {
 	Handler(printFunc interface{}) error
@@ -35,6 +46,8 @@ func AddHandlers(p Handler) error {
 		IngressHandler,
 		JobListHandler,
 		JobHandler,
+		LeaseListHandler,
+		LeaseHandler,
 		NodeHandler,
 		NodeListHandler,
 		NamespaceHandler,
@@ -59,6 +72,8 @@ func AddHandlers(p Handler) error {
 		SecretListHandler,
 		StatefulSetHandler,
 		StatefulSetListHandler,
+		RuntimeClassListHandler,
+		RuntimeClassHandler,
 		RoleBindingListHandler,
 		RoleBindingHandler,
 		RoleListHandler,
@@ -79,3 +94,75 @@ func AddHandlers(p Handler) error {
 
 	return nil
 }
+
+// CustomResourcePrintFunc prints a custom resource which has a well known schema.
+type CustomResourcePrintFunc func(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error)
+
+// CustomResourceHandlers is a registry of print funcs for custom resources
+// which have a well known schema. Custom resources without a registered
+// print func are printed using their definition's printer columns.
+type CustomResourceHandlers struct {
+	handlers map[schema.GroupVersionKind]CustomResourcePrintFunc
+}
+
+// NewCustomResourceHandlers creates an instance of CustomResourceHandlers.
+func NewCustomResourceHandlers() *CustomResourceHandlers {
+	return &CustomResourceHandlers{
+		handlers: make(map[schema.GroupVersionKind]CustomResourcePrintFunc),
+	}
+}
+
+// Handler registers a print func for a group/version/kind.
+func (c *CustomResourceHandlers) Handler(groupVersionKind schema.GroupVersionKind, printFunc CustomResourcePrintFunc) error {
+	if groupVersionKind.Kind == "" || groupVersionKind.Version == "" {
+		return errors.Errorf("invalid custom resource group/version/kind %q", groupVersionKind)
+	}
+
+	if printFunc == nil {
+		return errors.Errorf("print func for %s is nil", groupVersionKind)
+	}
+
+	if _, ok := c.handlers[groupVersionKind]; ok {
+		return errors.Errorf("registered duplicate printer for %s", groupVersionKind)
+	}
+
+	c.handlers[groupVersionKind] = printFunc
+
+	return nil
+}
+
+// PrintFunc returns the print func for a group/version/kind if one has been registered.
+func (c *CustomResourceHandlers) PrintFunc(groupVersionKind schema.GroupVersionKind) (CustomResourcePrintFunc, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	printFunc, ok := c.handlers[groupVersionKind]
+	return printFunc, ok
+}
+
+// AddCustomResourceHandlers adds print funcs for well known custom resources.
+func AddCustomResourceHandlers(c *CustomResourceHandlers) error {
+	handlers := []struct {
+		groupVersionKind schema.GroupVersionKind
+		printFunc        CustomResourcePrintFunc
+	}{
+		{groupVersionKind: gvk.VerticalPodAutoscaler, printFunc: VerticalPodAutoscalerHandler},
+		{groupVersionKind: gvk.Gateway, printFunc: GatewayHandler},
+		{groupVersionKind: gvk.GatewayV1Beta1, printFunc: GatewayHandler},
+		{groupVersionKind: gvk.HTTPRoute, printFunc: HTTPRouteHandler},
+		{groupVersionKind: gvk.HTTPRouteV1Beta1, printFunc: HTTPRouteHandler},
+		{groupVersionKind: gvk.ResourceClaim, printFunc: ResourceClaimHandler},
+		{groupVersionKind: gvk.ResourceClaimV1Beta1, printFunc: ResourceClaimHandler},
+		{groupVersionKind: gvk.DeviceClass, printFunc: DeviceClassHandler},
+		{groupVersionKind: gvk.DeviceClassV1Beta1, printFunc: DeviceClassHandler},
+	}
+
+	for _, handler := range handlers {
+		if err := c.Handler(handler.groupVersionKind, handler.printFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
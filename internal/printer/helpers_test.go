@@ -111,3 +111,27 @@ func genObjectStatus(status component.TextStatus, messages []string) component.L
 		l.SetStatus(status, list)
 	}
 }
+
+func statusText(s string, status component.TextStatus) *component.Text {
+	text := component.NewText(s)
+	text.SetStatus(status)
+	return text
+}
+
+// assertAgeBuckets asserts a table's rows are in the expected age buckets and the table
+// can be filtered by age bucket.
+func assertAgeBuckets(t *testing.T, got component.Component, expected []string) {
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+
+	var buckets []string
+	for _, row := range table.Rows() {
+		text, ok := row[ageBucketColumn].(*component.Text)
+		require.True(t, ok)
+		buckets = append(buckets, text.Config.Text)
+	}
+	require.Equal(t, expected, buckets)
+
+	require.Contains(t, table.Columns(), component.TableCol{Name: ageBucketColumn, Accessor: ageBucketColumn})
+	require.Equal(t, ageBuckets, table.Config.Filters[ageBucketColumn].Values)
+}
@@ -31,6 +31,7 @@ func HorizontalPodAutoscalerListHandler(ctx context.Context, list *autoscalingv1
 	cols := component.NewTableCols("Name", "Labels", "Targets", "Minimum Pods", "Maximum Pods", "Replicas", "Age")
 	ot := NewObjectTable("Horizontal Pod Autoscalers",
 		"We couldn't find any horizontal pod autoscalers", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, horizontalPodAutoscaler := range list.Items {
 		row := component.TableRow{}
@@ -50,7 +51,7 @@ func HorizontalPodAutoscalerListHandler(ctx context.Context, list *autoscalingv1
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(horizontalPodAutoscaler.Labels)
+		row["Labels"] = createLabelsView(&horizontalPodAutoscaler, horizontalPodAutoscaler.Labels, options)
 		row["Targets"] = component.NewText(aggregatedMetricTargets)
 		row["Minimum Pods"] = component.NewText(fmt.Sprintf("%d", *horizontalPodAutoscaler.Spec.MinReplicas))
 		row["Maximum Pods"] = component.NewText(fmt.Sprintf("%d", horizontalPodAutoscaler.Spec.MaxReplicas))
@@ -427,6 +428,13 @@ func (h *horizontalPodAutoscalerHandler) Metrics(ctx context.Context, options Op
 		return errors.New("can't parse annotations for metrics")
 	}
 
+	h.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createHorizontalPodAutoscalerMetricsView(ctx, h.horizontalPodAutoScaler, options)
+		},
+	})
+
 	return h.metrics(ctx, metricStatus, options)
 }
 
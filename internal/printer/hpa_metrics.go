@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	hpaMetricCols = component.NewTableCols("Type", "Name", "Selector", "Target", "Current")
+)
+
+// hpaMetric is a metric an autoscaling/v2 HorizontalPodAutoscaler scales on.
+type hpaMetric struct {
+	// metricType is the metric source type, e.g. Resource.
+	metricType string
+	// name identifies the metric within its type, e.g. the resource or metric name.
+	name string
+	// selector is the label selector of a Pods, Object, or External metric.
+	selector string
+	// target is the metric's target.
+	target map[string]interface{}
+}
+
+// createHorizontalPodAutoscalerMetricsView prints each metric a HorizontalPodAutoscaler scales
+// on with its type, target, and current reading. autoscaling/v2 metrics are not part of the
+// autoscaling/v1 types, so they are read from the HorizontalPodAutoscaler as autoscaling/v2.
+// Metrics without a current reading are flagged as errors. If the autoscaling/v2 HorizontalPodAutoscaler
+// can't be read, there is no view.
+func createHorizontalPodAutoscalerMetricsView(ctx context.Context, hpa *autoscalingv1.HorizontalPodAutoscaler, options Options) (component.Component, error) {
+	if hpa == nil {
+		return nil, errors.New("horizontalpodautoscaler is nil")
+	}
+
+	key := store.Key{
+		Namespace:  hpa.Namespace,
+		APIVersion: "autoscaling/v2",
+		Kind:       "HorizontalPodAutoscaler",
+		Name:       hpa.Name,
+	}
+
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		log.From(ctx).Errorf("get autoscaling/v2 horizontalpodautoscaler: %v", err)
+		return nil, nil
+	}
+
+	if object == nil {
+		return nil, nil
+	}
+
+	specMetrics, _, _ := unstructured.NestedSlice(object.Object, "spec", "metrics")
+	if len(specMetrics) == 0 {
+		return nil, nil
+	}
+
+	currents := make(map[string]map[string]interface{})
+	statusMetrics, _, _ := unstructured.NestedSlice(object.Object, "status", "currentMetrics")
+	for _, m := range statusMetrics {
+		status, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		metric, err := parseHPAMetric(status)
+		if err != nil {
+			return nil, err
+		}
+		current, _, _ := unstructured.NestedMap(status, hpaMetricSourceField(metric.metricType), "current")
+		currents[metric.key()] = current
+	}
+
+	table := component.NewTable("Metrics", "There are no metrics!", hpaMetricCols)
+
+	for _, m := range specMetrics {
+		spec, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metric, err := parseHPAMetric(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		var current *component.Text
+		if reading, ok := currents[metric.key()]; ok {
+			current = component.NewText(formatHPAMetricCurrent(metric.target, reading))
+		} else {
+			current = component.NewText("<unknown>")
+			current.SetStatus(component.TextStatusError)
+		}
+
+		table.Add(component.TableRow{
+			"Type":     component.NewText(metric.metricType),
+			"Name":     component.NewText(metric.name),
+			"Selector": component.NewText(metric.selector),
+			"Target":   component.NewText(formatHPAMetricTarget(metric.target)),
+			"Current":  current,
+		})
+	}
+
+	return table, nil
+}
+
+// key identifies a metric, so a metric's spec can be matched with its status.
+func (m hpaMetric) key() string {
+	return strings.Join([]string{m.metricType, m.name, m.selector}, "/")
+}
+
+// hpaMetricSourceField returns the field containing a metric type's source, e.g.
+// containerResource for ContainerResource metrics.
+func hpaMetricSourceField(metricType string) string {
+	if metricType == "" {
+		return ""
+	}
+	return strings.ToLower(metricType[:1]) + metricType[1:]
+}
+
+// parseHPAMetric parses an autoscaling/v2 metric spec or status.
+func parseHPAMetric(m map[string]interface{}) (hpaMetric, error) {
+	metricType, _, _ := unstructured.NestedString(m, "type")
+	source, _, _ := unstructured.NestedMap(m, hpaMetricSourceField(metricType))
+
+	metric := hpaMetric{metricType: metricType}
+	metric.target, _, _ = unstructured.NestedMap(source, "target")
+
+	switch metricType {
+	case "Resource":
+		metric.name, _, _ = unstructured.NestedString(source, "name")
+	case "ContainerResource":
+		name, _, _ := unstructured.NestedString(source, "name")
+		container, _, _ := unstructured.NestedString(source, "container")
+		metric.name = fmt.Sprintf("%s (container %s)", name, container)
+	case "Pods", "Object", "External":
+		metric.name, _, _ = unstructured.NestedString(source, "metric", "name")
+
+		if selector, found, _ := unstructured.NestedMap(source, "metric", "selector"); found {
+			s, err := formatHPAMetricSelector(selector)
+			if err != nil {
+				return hpaMetric{}, err
+			}
+			metric.selector = s
+		}
+
+		if metricType == "Object" {
+			kind, _, _ := unstructured.NestedString(source, "describedObject", "kind")
+			name, _, _ := unstructured.NestedString(source, "describedObject", "name")
+			metric.name = fmt.Sprintf("%s on %s %s", metric.name, kind, name)
+		}
+	}
+
+	return metric, nil
+}
+
+func formatHPAMetricSelector(m map[string]interface{}) (string, error) {
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &labelSelector); err != nil {
+		return "", errors.Wrap(err, "convert metric selector")
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return "", errors.Wrap(err, "convert metric selector")
+	}
+
+	return selector.String(), nil
+}
+
+// formatHPAMetricTarget prints a metric target, e.g. "80% average utilization".
+func formatHPAMetricTarget(target map[string]interface{}) string {
+	targetType, _, _ := unstructured.NestedString(target, "type")
+
+	switch targetType {
+	case "Utilization":
+		utilization, _, _ := unstructured.NestedInt64(target, "averageUtilization")
+		return fmt.Sprintf("%d%% average utilization", utilization)
+	case "AverageValue":
+		return fmt.Sprintf("%s average value", hpaQuantity(target, "averageValue"))
+	case "Value":
+		return fmt.Sprintf("%s value", hpaQuantity(target, "value"))
+	default:
+		return "<unknown>"
+	}
+}
+
+// formatHPAMetricCurrent prints a metric's current reading in the form of its target.
+func formatHPAMetricCurrent(target, current map[string]interface{}) string {
+	targetType, _, _ := unstructured.NestedString(target, "type")
+
+	if utilization, found, _ := unstructured.NestedInt64(current, "averageUtilization"); found && targetType == "Utilization" {
+		return fmt.Sprintf("%d%%", utilization)
+	}
+
+	if value := hpaQuantity(current, "averageValue"); value != "" && targetType != "Value" {
+		return value
+	}
+
+	if value := hpaQuantity(current, "value"); value != "" {
+		return value
+	}
+
+	return "<unknown>"
+}
+
+// hpaQuantity returns a quantity field as a string. Quantities are strings, but small
+// values may be numbers.
+func hpaQuantity(m map[string]interface{}, field string) string {
+	value, found := m[field]
+	if !found || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createHorizontalPodAutoscalerMetricsView(t *testing.T) {
+	specMetrics := []interface{}{
+		map[string]interface{}{
+			"type": "Resource",
+			"resource": map[string]interface{}{
+				"name":   "cpu",
+				"target": map[string]interface{}{"type": "Utilization", "averageUtilization": int64(80)},
+			},
+		},
+		map[string]interface{}{
+			"type": "ContainerResource",
+			"containerResource": map[string]interface{}{
+				"name":      "memory",
+				"container": "app",
+				"target":    map[string]interface{}{"type": "AverageValue", "averageValue": "500Mi"},
+			},
+		},
+		map[string]interface{}{
+			"type": "Object",
+			"object": map[string]interface{}{
+				"metric":          map[string]interface{}{"name": "requests-per-second"},
+				"describedObject": map[string]interface{}{"apiVersion": "networking.k8s.io/v1", "kind": "Ingress", "name": "main"},
+				"target":          map[string]interface{}{"type": "Value", "value": "10k"},
+			},
+		},
+		map[string]interface{}{
+			"type": "External",
+			"external": map[string]interface{}{
+				"metric": map[string]interface{}{
+					"name":     "queue_messages_ready",
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"queue": "worker_tasks"}},
+				},
+				"target": map[string]interface{}{"type": "AverageValue", "averageValue": "30"},
+			},
+		},
+	}
+
+	statusMetrics := []interface{}{
+		map[string]interface{}{
+			"type": "Resource",
+			"resource": map[string]interface{}{
+				"name":    "cpu",
+				"current": map[string]interface{}{"averageUtilization": int64(45), "averageValue": "225m"},
+			},
+		},
+		map[string]interface{}{
+			"type": "ContainerResource",
+			"containerResource": map[string]interface{}{
+				"name":      "memory",
+				"container": "app",
+				"current":   map[string]interface{}{"averageValue": "300Mi"},
+			},
+		},
+		map[string]interface{}{
+			"type": "Object",
+			"object": map[string]interface{}{
+				"metric":          map[string]interface{}{"name": "requests-per-second"},
+				"describedObject": map[string]interface{}{"apiVersion": "networking.k8s.io/v1", "kind": "Ingress", "name": "main"},
+				"current":         map[string]interface{}{"value": "2k"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		notFound bool
+		metrics  bool
+		expected func() component.Component
+	}{
+		{
+			name:     "horizontalpodautoscaler not in cache",
+			notFound: true,
+		},
+		{
+			name: "no metrics",
+		},
+		{
+			name:    "metrics",
+			metrics: true,
+			expected: func() component.Component {
+				unknown := component.NewText("<unknown>")
+				unknown.SetStatus(component.TextStatusError)
+
+				table := component.NewTable("Metrics", "There are no metrics!", hpaMetricCols)
+				table.Add(
+					component.TableRow{
+						"Type":     component.NewText("Resource"),
+						"Name":     component.NewText("cpu"),
+						"Selector": component.NewText(""),
+						"Target":   component.NewText("80% average utilization"),
+						"Current":  component.NewText("45%"),
+					},
+					component.TableRow{
+						"Type":     component.NewText("ContainerResource"),
+						"Name":     component.NewText("memory (container app)"),
+						"Selector": component.NewText(""),
+						"Target":   component.NewText("500Mi average value"),
+						"Current":  component.NewText("300Mi"),
+					},
+					component.TableRow{
+						"Type":     component.NewText("Object"),
+						"Name":     component.NewText("requests-per-second on Ingress main"),
+						"Selector": component.NewText(""),
+						"Target":   component.NewText("10k value"),
+						"Current":  component.NewText("2k"),
+					},
+					component.TableRow{
+						"Type":     component.NewText("External"),
+						"Name":     component.NewText("queue_messages_ready"),
+						"Selector": component.NewText("queue=worker_tasks"),
+						"Target":   component.NewText("30 average value"),
+						"Current":  unknown,
+					},
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			hpa := testutil.CreateHorizontalPodAutoscaler("hpa")
+
+			var object *unstructured.Unstructured
+			if !test.notFound {
+				object = testutil.ToUnstructured(t, hpa)
+				object.SetAPIVersion("autoscaling/v2")
+				if test.metrics {
+					require.NoError(t, unstructured.SetNestedSlice(object.Object, specMetrics, "spec", "metrics"))
+					require.NoError(t, unstructured.SetNestedSlice(object.Object, statusMetrics, "status", "currentMetrics"))
+				}
+			}
+
+			key := store.Key{Namespace: "namespace", APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", Name: "hpa"}
+			tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+
+			got, err := createHorizontalPodAutoscalerMetricsView(ctx, hpa, tpo.ToOptions())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// dockerHubRegistry is the registry images without an explicit registry are pulled from.
+	dockerHubRegistry = "docker.io"
+)
+
+// imageRegistry is a registry used by a workload's images.
+type imageRegistry struct {
+	// implicit is true if an image doesn't name the registry.
+	implicit bool
+	images   []string
+}
+
+// createImageRegistryView prints the distinct registries a workload's containers pull images
+// from as a row of badges. Images without an explicit registry are pulled from Docker Hub.
+// If options.AllowedImageRegistries is set, registries are marked as allowed or flagged.
+func createImageRegistryView(template corev1.PodTemplateSpec, options Options) (component.Component, error) {
+	registries := make(map[string]*imageRegistry)
+
+	var containers []corev1.Container
+	containers = append(containers, template.Spec.InitContainers...)
+	containers = append(containers, template.Spec.Containers...)
+
+	for _, c := range containers {
+		if c.Image == "" {
+			continue
+		}
+
+		name, explicit := parseImageRegistry(c.Image)
+		registry, ok := registries[name]
+		if !ok {
+			registry = &imageRegistry{implicit: true}
+			registries[name] = registry
+		}
+		registry.implicit = registry.implicit && !explicit
+		registry.images = append(registry.images, c.Image)
+	}
+
+	if len(registries) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for name := range registries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	badges := component.NewStatusBadges("")
+
+	var disallowed []string
+	for _, name := range names {
+		registry := registries[name]
+
+		label := name
+		if registry.implicit {
+			label = fmt.Sprintf("%s (Docker Hub)", name)
+		}
+
+		var status component.TextStatus
+		switch {
+		case len(options.AllowedImageRegistries) == 0:
+		case options.imageRegistryAllowed(name):
+			status = component.TextStatusOK
+		default:
+			status = component.TextStatusWarning
+			disallowed = append(disallowed, name)
+		}
+
+		badges.Add(label, status, strings.Join(registry.images, ", "))
+	}
+
+	summary := component.NewSummary("Image Registries", component.SummarySection{
+		Header:  "Registries",
+		Content: badges,
+	})
+
+	if len(disallowed) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Images are pulled from registries which aren't allowed: %s", strings.Join(disallowed, ", "))))
+	}
+
+	return summary, nil
+}
+
+// imageRegistryAllowed returns true if a registry is in options.AllowedImageRegistries.
+func (o Options) imageRegistryAllowed(registry string) bool {
+	for _, allowed := range o.AllowedImageRegistries {
+		if name, _ := parseImageRegistry(allowed + "/image"); name == registry {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseImageRegistry returns the registry of an image reference, and whether the reference
+// names the registry. Like Docker, the first component of a reference is a registry only if
+// it contains a "." or ":", or is "localhost". Docker Hub aliases are normalized.
+func parseImageRegistry(image string) (string, bool) {
+	i := strings.Index(image, "/")
+	if i == -1 {
+		return dockerHubRegistry, false
+	}
+
+	registry := image[:i]
+	if !strings.ContainsAny(registry, ".:") && registry != "localhost" {
+		return dockerHubRegistry, false
+	}
+
+	switch registry {
+	case "index.docker.io", "registry-1.docker.io":
+		registry = dockerHubRegistry
+	}
+
+	return registry, true
+}
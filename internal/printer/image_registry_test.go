@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createImageRegistryView(t *testing.T) {
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "init", Image: "busybox:1.28"},
+			},
+			Containers: []corev1.Container{
+				{Name: "app", Image: "gcr.io/project/app:v1"},
+				{Name: "proxy", Image: "registry.example.com:5000/proxy@sha256:abc"},
+				{Name: "sidecar", Image: "library/nginx"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template corev1.PodTemplateSpec
+		options  Options
+		expected func() component.Component
+	}{
+		{
+			name: "no images",
+		},
+		{
+			name:     "registries",
+			template: template,
+			expected: func() component.Component {
+				badges := component.NewStatusBadges("")
+				badges.Add("docker.io (Docker Hub)", 0, "busybox:1.28, library/nginx")
+				badges.Add("gcr.io", 0, "gcr.io/project/app:v1")
+				badges.Add("registry.example.com:5000", 0, "registry.example.com:5000/proxy@sha256:abc")
+
+				return component.NewSummary("Image Registries", component.SummarySection{
+					Header: "Registries", Content: badges,
+				})
+			},
+		},
+		{
+			name:     "allowed registries",
+			template: template,
+			options:  Options{AllowedImageRegistries: []string{"docker.io", "registry.example.com:5000"}},
+			expected: func() component.Component {
+				badges := component.NewStatusBadges("")
+				badges.Add("docker.io (Docker Hub)", component.TextStatusOK, "busybox:1.28, library/nginx")
+				badges.Add("gcr.io", component.TextStatusWarning, "gcr.io/project/app:v1")
+				badges.Add("registry.example.com:5000", component.TextStatusOK, "registry.example.com:5000/proxy@sha256:abc")
+
+				summary := component.NewSummary("Image Registries", component.SummarySection{
+					Header: "Registries", Content: badges,
+				})
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Images are pulled from registries which aren't allowed: gcr.io"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := createImageRegistryView(test.template, test.options)
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+func Test_parseImageRegistry(t *testing.T) {
+	tests := []struct {
+		image            string
+		expected         string
+		expectedExplicit bool
+	}{
+		{image: "nginx", expected: "docker.io"},
+		{image: "nginx:1.15", expected: "docker.io"},
+		{image: "library/nginx", expected: "docker.io"},
+		{image: "docker.io/library/nginx", expected: "docker.io", expectedExplicit: true},
+		{image: "index.docker.io/library/nginx", expected: "docker.io", expectedExplicit: true},
+		{image: "gcr.io/project/app", expected: "gcr.io", expectedExplicit: true},
+		{image: "localhost/app", expected: "localhost", expectedExplicit: true},
+		{image: "registry:5000/app", expected: "registry:5000", expectedExplicit: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			got, explicit := parseImageRegistry(test.image)
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, test.expectedExplicit, explicit)
+		})
+	}
+}
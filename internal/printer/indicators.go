@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// DefaultAvailabilityThreshold is the availability percentage below which a
+	// workload's availability indicator is an error.
+	DefaultAvailabilityThreshold = 80
+	// restartsPerPodErrorThreshold is the average number of restarts per pod at
+	// which a workload's restarts indicator is an error.
+	restartsPerPodErrorThreshold = 5
+)
+
+// createWorkloadIndicators creates a summary of a workload's availability, container
+// restarts, and age. Availability is the percentage of desired replicas which are running
+// with all containers ready. Its status uses the workload kind's status threshold.
+func createWorkloadIndicators(ctx context.Context, workload runtime.Object, selector *metav1.LabelSelector, desired int32, options Options) (*component.Summary, error) {
+	if workload == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	object, err := meta.Accessor(workload)
+	if err != nil {
+		return nil, err
+	}
+
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	var pods []*corev1.Pod
+	if selector != nil {
+		pods, err = loadPods(ctx, key, options.DashConfig.ObjectStore(), selector)
+		if err != nil {
+			return nil, errors.Wrap(err, "load pods")
+		}
+	}
+
+	ps := createPodStatus(pods)
+	ready := ps.Running - ps.Degraded
+
+	var restarts int32
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+	}
+
+	groupVersionKind, err := objectGroupVersionKind(workload)
+	if err != nil {
+		return nil, err
+	}
+	threshold := options.availabilityThreshold(groupVersionKind)
+
+	sections := component.SummarySections{}
+	sections.Add("Availability", availabilityIndicator(ready, int(desired), threshold))
+	sections.Add("Restarts", restartsIndicator(int(restarts), len(pods)))
+	sections.Add("Age", component.NewTimestamp(object.GetCreationTimestamp().Time))
+
+	return component.NewSummary("Indicators", sections...), nil
+}
+
+func availabilityIndicator(ready, desired int, threshold ThresholdConfig) *component.Text {
+	if desired == 0 {
+		return component.NewText(fmt.Sprintf("%d/0", ready))
+	}
+
+	text := component.NewText(fmt.Sprintf("%d/%d (%d%%)", ready, desired, ready*100/desired))
+	text.SetStatus(threshold.Status(ready, desired))
+
+	return text
+}
+
+func restartsIndicator(restarts, pods int) *component.Text {
+	text := component.NewText(fmt.Sprintf("%d across %d pods", restarts, pods))
+	switch {
+	case restarts == 0:
+		text.SetStatus(component.TextStatusOK)
+	case restarts >= restartsPerPodErrorThreshold*pods:
+		text.SetStatus(component.TextStatusError)
+	default:
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
+
+// replicasOrDefault returns the desired number of replicas, which defaults to one.
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
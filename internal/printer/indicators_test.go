@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createWorkloadIndicators(t *testing.T) {
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "web"},
+	}
+
+	workloadPod := func(name string, phase corev1.PodPhase, ready bool, restarts int32) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Labels = map[string]string{"app": "web"}
+		pod.Spec.Containers = []corev1.Container{{Name: "container"}}
+		pod.Status.Phase = phase
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+			{Name: "container", Ready: ready, RestartCount: restarts},
+		}
+		return pod
+	}
+
+	indicatorText := func(s string, status component.TextStatus) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(status)
+		return text
+	}
+
+	deployment := testutil.CreateDeployment("deployment")
+
+	tests := []struct {
+		name      string
+		pods      []runtime.Object
+		desired   int32
+		threshold int
+		override  *ThresholdConfig
+		expected  func() *component.Summary
+	}{
+		{
+			name: "all replicas available",
+			pods: []runtime.Object{
+				workloadPod("pod-1", corev1.PodRunning, true, 0),
+				workloadPod("pod-2", corev1.PodRunning, true, 0),
+			},
+			desired: 2,
+			expected: func() *component.Summary {
+				sections := component.SummarySections{}
+				sections.Add("Availability", indicatorText("2/2 (100%)", component.TextStatusOK))
+				sections.Add("Restarts", indicatorText("0 across 2 pods", component.TextStatusOK))
+				sections.Add("Age", component.NewTimestamp(deployment.CreationTimestamp.Time))
+				return component.NewSummary("Indicators", sections...)
+			},
+		},
+		{
+			name: "degraded replica",
+			pods: []runtime.Object{
+				workloadPod("pod-1", corev1.PodRunning, true, 1),
+				workloadPod("pod-2", corev1.PodRunning, true, 0),
+				workloadPod("pod-3", corev1.PodRunning, true, 0),
+				workloadPod("pod-4", corev1.PodRunning, true, 0),
+				workloadPod("pod-5", corev1.PodRunning, false, 3),
+			},
+			desired: 5,
+			expected: func() *component.Summary {
+				sections := component.SummarySections{}
+				sections.Add("Availability", indicatorText("4/5 (80%)", component.TextStatusWarning))
+				sections.Add("Restarts", indicatorText("4 across 5 pods", component.TextStatusWarning))
+				sections.Add("Age", component.NewTimestamp(deployment.CreationTimestamp.Time))
+				return component.NewSummary("Indicators", sections...)
+			},
+		},
+		{
+			name: "availability below threshold",
+			pods: []runtime.Object{
+				workloadPod("pod-1", corev1.PodRunning, true, 0),
+				workloadPod("pod-2", corev1.PodPending, false, 12),
+			},
+			desired:   2,
+			threshold: 90,
+			expected: func() *component.Summary {
+				sections := component.SummarySections{}
+				sections.Add("Availability", indicatorText("1/2 (50%)", component.TextStatusError))
+				sections.Add("Restarts", indicatorText("12 across 2 pods", component.TextStatusError))
+				sections.Add("Age", component.NewTimestamp(deployment.CreationTimestamp.Time))
+				return component.NewSummary("Indicators", sections...)
+			},
+		},
+		{
+			name: "status threshold override",
+			pods: []runtime.Object{
+				workloadPod("pod-1", corev1.PodRunning, true, 0),
+				workloadPod("pod-2", corev1.PodRunning, true, 0),
+				workloadPod("pod-3", corev1.PodRunning, true, 0),
+				workloadPod("pod-4", corev1.PodRunning, true, 0),
+				workloadPod("pod-5", corev1.PodPending, false, 0),
+			},
+			desired:  5,
+			override: &ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+			expected: func() *component.Summary {
+				sections := component.SummarySections{}
+				sections.Add("Availability", indicatorText("4/5 (80%)", component.TextStatusOK))
+				sections.Add("Restarts", indicatorText("0 across 5 pods", component.TextStatusOK))
+				sections.Add("Age", component.NewTimestamp(deployment.CreationTimestamp.Time))
+				return component.NewSummary("Indicators", sections...)
+			},
+		},
+		{
+			name:    "scaled to zero",
+			desired: 0,
+			expected: func() *component.Summary {
+				sections := component.SummarySections{}
+				sections.Add("Availability", component.NewText("0/0"))
+				sections.Add("Restarts", indicatorText("0 across 0 pods", component.TextStatusOK))
+				sections.Add("Age", component.NewTimestamp(deployment.CreationTimestamp.Time))
+				return component.NewSummary("Indicators", sections...)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+			tpo.objectStore.EXPECT().List(ctx, key).Return(testutil.ToUnstructuredList(t, test.pods...), false, nil)
+
+			options := tpo.ToOptions()
+			options.AvailabilityThreshold = test.threshold
+			if test.override != nil {
+				require.NoError(t, options.SetStatusThreshold(deployment.GroupVersionKind(), *test.override))
+			}
+
+			got, err := createWorkloadIndicators(ctx, deployment, selector, test.desired, options)
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
@@ -26,6 +26,7 @@ func IngressListHandler(ctx context.Context, list *extv1beta1.IngressList, optio
 
 	cols := component.NewTableCols("Name", "Labels", "Hosts", "Address", "Ports", "Age")
 	ot := NewObjectTable("Ingresses", "We couldn't find any ingresses!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, ingress := range list.Items {
 		ports := "80"
@@ -40,7 +41,7 @@ func IngressListHandler(ctx context.Context, list *extv1beta1.IngressList, optio
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(ingress.Labels)
+		row["Labels"] = createLabelsView(&ingress, ingress.Labels, options)
 		row["Hosts"] = component.NewText(formatIngressHosts(ingress.Spec.Rules))
 		row["Address"] = component.NewText(loadBalancerStatusStringer(ingress.Status.LoadBalancer))
 		row["Ports"] = component.NewText(ports)
@@ -72,6 +73,10 @@ func IngressHandler(ctx context.Context, ingress *extv1beta1.Ingress, options Op
 		return nil, errors.Wrap(err, "print ingress rules")
 	}
 
+	if err := ih.TLS(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print ingress TLS certificates")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -231,11 +236,13 @@ func NewIngressConfiguration(ingress *extv1beta1.Ingress) *IngressConfiguration
 type ingressObject interface {
 	Config(options Options) error
 	Rules(options Options) error
+	TLS(ctx context.Context, options Options) error
 }
 type ingressHandler struct {
 	ingress    *extv1beta1.Ingress
 	configFunc func(*extv1beta1.Ingress, Options) (*component.Summary, error)
 	rulesFunc  func(*extv1beta1.Ingress, Options) (*component.Table, error)
+	tlsFunc    func(context.Context, *extv1beta1.Ingress, Options) (component.Component, error)
 	object     *Object
 }
 
@@ -254,6 +261,7 @@ func newIngressHandler(ingress *extv1beta1.Ingress, object *Object) (*ingressHan
 		ingress:    ingress,
 		configFunc: defaultIngressConfig,
 		rulesFunc:  defaultIngressRules,
+		tlsFunc:    defaultIngressTLS,
 		object:     object,
 	}
 
@@ -291,3 +299,22 @@ func (i *ingressHandler) Rules(options Options) error {
 func defaultIngressRules(ingress *extv1beta1.Ingress, options Options) (*component.Table, error) {
 	return createIngressRulesView(ingress, options)
 }
+
+func (i *ingressHandler) TLS(ctx context.Context, options Options) error {
+	if i.ingress == nil {
+		return errors.New("can't print TLS certificates for nil ingress")
+	}
+
+	i.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return i.tlsFunc(ctx, i.ingress, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultIngressTLS(ctx context.Context, ingress *extv1beta1.Ingress, options Options) (component.Component, error) {
+	return createIngressTLSView(ctx, ingress, options)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	ingressTLSCols = component.NewTableCols("Secret", "Hosts", "Expires", "Status")
+)
+
+// createIngressTLSView prints the certificate behind each of an ingress's TLS entries, and
+// whether it covers the entry's hosts. Missing secrets and certificates, expired certificates,
+// and hosts not in a certificate's SANs are flagged as errors. Ingresses without TLS have no view.
+func createIngressTLSView(ctx context.Context, ingress *extv1beta1.Ingress, options Options) (component.Component, error) {
+	if ingress == nil {
+		return nil, errors.New("ingress is nil")
+	}
+
+	if len(ingress.Spec.TLS) == 0 {
+		return nil, nil
+	}
+
+	c := options.Clock
+	if c == nil {
+		c = clock.RealClock{}
+	}
+	now := c.Now()
+
+	table := component.NewTable("TLS Certificates", "There is no TLS configured!", ingressTLSCols)
+
+	for _, tls := range ingress.Spec.TLS {
+		row := component.TableRow{
+			"Secret":  component.NewText(tls.SecretName),
+			"Hosts":   component.NewText(formatIngressTLSHosts(tls.Hosts)),
+			"Expires": component.NewText("<unknown>"),
+		}
+
+		errorStatus := func(format string, a ...interface{}) {
+			status := component.NewTextf(format, a...)
+			status.SetStatus(component.TextStatusError)
+			row["Status"] = status
+		}
+
+		key := store.Key{
+			Namespace:  ingress.Namespace,
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Name:       tls.SecretName,
+		}
+
+		object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "get TLS secret")
+		}
+
+		if object == nil {
+			errorStatus("Secret not found")
+			table.Add(row)
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, secret); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured secret")
+		}
+
+		secretLink, err := options.Link.ForObject(secret, secret.Name)
+		if err != nil {
+			return nil, err
+		}
+		row["Secret"] = secretLink
+
+		cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+		if err == errNoCertificate {
+			errorStatus("Unable to find a certificate")
+			table.Add(row)
+			continue
+		} else if err != nil {
+			errorStatus("Unable to parse certificate: %s", err)
+			table.Add(row)
+			continue
+		}
+
+		row["Expires"] = component.NewCountdown(cert.NotAfter)
+
+		var uncovered []string
+		for _, host := range tls.Hosts {
+			if err := cert.VerifyHostname(host); err != nil {
+				uncovered = append(uncovered, host)
+			}
+		}
+
+		switch {
+		case len(uncovered) > 0:
+			errorStatus("Not covered by certificate: %s", strings.Join(uncovered, ", "))
+		case now.After(cert.NotAfter):
+			errorStatus("Certificate expired")
+		default:
+			status := component.NewText("Certificate covers hosts")
+			status.SetStatus(component.TextStatusOK)
+			row["Status"] = status
+		}
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+func formatIngressTLSHosts(hosts []string) string {
+	if len(hosts) == 0 {
+		return "<none>"
+	}
+	return strings.Join(hosts, ", ")
+}
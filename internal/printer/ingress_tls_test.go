@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createIngressTLSView(t *testing.T) {
+	now := testutil.Time()
+	notAfter := now.Add(48 * time.Hour)
+
+	newSecret := func(name string, data []byte) *corev1.Secret {
+		secret := testutil.CreateSecret(name)
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{corev1.TLSCertKey: data}
+		return secret
+	}
+
+	status := func(s string, textStatus component.TextStatus) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(textStatus)
+		return text
+	}
+
+	link := func(name string) *component.Link {
+		return component.NewLink("", name, "/"+name)
+	}
+
+	tests := []struct {
+		name     string
+		tls      []extv1beta1.IngressTLS
+		secrets  []*corev1.Secret
+		expected func() *component.Table
+	}{
+		{
+			name: "no TLS",
+		},
+		{
+			name: "TLS certificates",
+			tls: []extv1beta1.IngressTLS{
+				{Hosts: []string{"example.com", "www.example.com"}, SecretName: "covered"},
+				{Hosts: []string{"api.example.com", "example.org"}, SecretName: "mismatch"},
+				{Hosts: []string{"old.example.com"}, SecretName: "expired"},
+				{Hosts: []string{"example.net"}, SecretName: "missing"},
+				{SecretName: "opaque"},
+			},
+			secrets: []*corev1.Secret{
+				newSecret("covered", createTestCertificateForHosts(t, notAfter, "example.com", "www.example.com")),
+				newSecret("mismatch", createTestCertificateForHosts(t, notAfter, "*.example.com")),
+				newSecret("expired", createTestCertificateForHosts(t, now.Add(-time.Hour), "old.example.com")),
+				newSecret("opaque", []byte("not a certificate")),
+			},
+			expected: func() *component.Table {
+				table := component.NewTable("TLS Certificates", "There is no TLS configured!", ingressTLSCols)
+				table.Add(
+					component.TableRow{
+						"Secret":  link("covered"),
+						"Hosts":   component.NewText("example.com, www.example.com"),
+						"Expires": component.NewCountdown(notAfter),
+						"Status":  status("Certificate covers hosts", component.TextStatusOK),
+					},
+					component.TableRow{
+						"Secret":  link("mismatch"),
+						"Hosts":   component.NewText("api.example.com, example.org"),
+						"Expires": component.NewCountdown(notAfter),
+						"Status":  status("Not covered by certificate: example.org", component.TextStatusError),
+					},
+					component.TableRow{
+						"Secret":  link("expired"),
+						"Hosts":   component.NewText("old.example.com"),
+						"Expires": component.NewCountdown(now.Add(-time.Hour)),
+						"Status":  status("Certificate expired", component.TextStatusError),
+					},
+					component.TableRow{
+						"Secret":  component.NewText("missing"),
+						"Hosts":   component.NewText("example.net"),
+						"Expires": component.NewText("<unknown>"),
+						"Status":  status("Secret not found", component.TextStatusError),
+					},
+					component.TableRow{
+						"Secret":  link("opaque"),
+						"Hosts":   component.NewText("<none>"),
+						"Expires": component.NewText("<unknown>"),
+						"Status":  status("Unable to find a certificate", component.TextStatusError),
+					},
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			var cached []runtime.Object
+			for _, secret := range test.secrets {
+				cached = append(cached, secret)
+				tpo.link.EXPECT().ForObject(gomock.Any(), secret.Name).Return(link(secret.Name), nil)
+			}
+			stubProblemsStore(t, tpo, cached)
+
+			ingress := testutil.CreateIngress("ingress")
+			ingress.Spec.TLS = test.tls
+
+			options := tpo.ToOptions()
+			options.Clock = clock.NewFakeClock(now)
+
+			got, err := createIngressTLSView(ctx, ingress, options)
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+// createTestCertificateForHosts creates a PEM encoded self-signed certificate with hosts as its SANs.
+func createTestCertificateForHosts(t *testing.T, notAfter time.Time, hosts ...string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		DNSNames:     hosts,
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
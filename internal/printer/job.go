@@ -8,20 +8,25 @@ package printer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 var (
-	JobCols = component.NewTableCols("Name", "Labels", "Completions", "Successful", "Age")
+	JobCols = component.NewTableCols("Name", "Labels", "Status", "Completions", "Successful", "Age")
 )
 
 // JobListHandler prints a job list.
@@ -31,6 +36,7 @@ func JobListHandler(ctx context.Context, list *batchv1.JobList, opts Options) (c
 	}
 
 	ot := NewObjectTable("Jobs", "We couldn't find any jobs!", JobCols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
 
 	for _, job := range list.Items {
 		row := component.TableRow{}
@@ -41,7 +47,8 @@ func JobListHandler(ctx context.Context, list *batchv1.JobList, opts Options) (c
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(job.Labels)
+		row["Labels"] = createLabelsView(&job, job.Labels, opts)
+		row["Status"] = jobStatusBadges(&job)
 		row["Completions"] = component.NewText(conversion.PtrInt32ToString(job.Spec.Completions))
 		succeeded := fmt.Sprintf("%d", job.Status.Succeeded)
 		row["Successful"] = component.NewText(succeeded)
@@ -55,10 +62,36 @@ func JobListHandler(ctx context.Context, list *batchv1.JobList, opts Options) (c
 	return ot.ToComponent()
 }
 
+// jobStatusBadges prints a badge for each active job condition. A job
+// without active conditions that has running pods is shown as running.
+func jobStatusBadges(job *batchv1.Job) *component.StatusBadges {
+	badges := component.NewStatusBadges("")
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case batchv1.JobFailed:
+			badges.Add(conditionBadgeLabel(string(condition.Type), condition.Reason), component.TextStatusError, condition.Message)
+		default:
+			badges.Add(string(condition.Type), component.TextStatusOK, condition.Message)
+		}
+	}
+
+	if len(badges.Badges()) == 0 && job.Status.Active > 0 {
+		badges.AddNeutral("Running", fmt.Sprintf("%d active", job.Status.Active))
+	}
+
+	return badges
+}
+
 // JobHandler printers a job.
 func JobHandler(ctx context.Context, job *batchv1.Job, options Options) (component.Component, error) {
 	o := NewObject(job)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	jh, err := newJobHandler(job, o)
 	if err != nil {
@@ -73,6 +106,10 @@ func JobHandler(ctx context.Context, job *batchv1.Job, options Options) (compone
 		return nil, errors.Wrap(err, "print job status")
 	}
 
+	if err := jh.Suspend(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print job suspend state")
+	}
+
 	if err := jh.Pods(ctx, job, options); err != nil {
 		return nil, errors.Wrap(err, "print job pods")
 	}
@@ -110,10 +147,31 @@ func (j *JobConfiguration) Create(option Options) (*component.Summary, error) {
 	sections.Add("Completions", component.NewText(conversion.PtrInt32ToString(job.Spec.Completions)))
 	sections.Add("Parallelism", component.NewText(conversion.PtrInt32ToString(job.Spec.Parallelism)))
 
+	if ads := job.Spec.ActiveDeadlineSeconds; ads != nil {
+		sections.AddText("Active Deadline Seconds", fmt.Sprintf("%ds", *ads))
+	}
+
 	summary := component.NewSummary("Configuration", sections...)
 	return summary, nil
 }
 
+const (
+	jobActiveDeadlineWarningThreshold = 10 * time.Minute
+	jobActiveDeadlineErrorThreshold   = time.Minute
+)
+
+// jobActiveDeadline returns the time at which a started job will be terminated
+// because it has exceeded its active deadline.
+func jobActiveDeadline(job batchv1.Job) (time.Time, bool) {
+	ads := job.Spec.ActiveDeadlineSeconds
+	startTime := job.Status.StartTime
+	if ads == nil || startTime == nil {
+		return time.Time{}, false
+	}
+
+	return startTime.Add(time.Duration(*ads) * time.Second), true
+}
+
 func createJobStatus(job batchv1.Job) (*component.Summary, error) {
 	sections := component.SummarySections{}
 
@@ -123,6 +181,9 @@ func createJobStatus(job batchv1.Job) (*component.Summary, error) {
 
 	if completionTime := job.Status.CompletionTime; completionTime != nil {
 		sections.Add("Completed", component.NewTimestamp(completionTime.Time))
+	} else if deadline, ok := jobActiveDeadline(job); ok {
+		sections.Add("Active Deadline", component.NewCountdown(deadline,
+			component.WithCountdownThresholds(jobActiveDeadlineWarningThreshold, jobActiveDeadlineErrorThreshold)))
 	}
 
 	sections.Add("Succeeded", component.NewText(fmt.Sprintf("%d", job.Status.Succeeded)))
@@ -213,6 +274,7 @@ func createJobListView(ctx context.Context, object runtime.Object, options Optio
 type jobObject interface {
 	Config(options Options) error
 	Status(options Options) error
+	Suspend(ctx context.Context, options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
 	Conditions(options Options) error
 }
@@ -275,6 +337,77 @@ func defaultJobStatus(job *batchv1.Job, options Options) (*component.Summary, er
 	return createJobStatus(*job)
 }
 
+// Suspend prints whether the job is suspended, and adds a button to suspend or resume it.
+// Jobs served by API versions without spec.suspend have no suspend state, so nothing is printed.
+func (j *jobHandler) Suspend(ctx context.Context, options Options) error {
+	if j.job == nil {
+		return errors.New("can't display suspend state for nil job")
+	}
+
+	key, err := store.KeyFromObject(j.job)
+	if err != nil {
+		return err
+	}
+
+	suspended, supported, err := jobSuspendState(ctx, key, options)
+	if err != nil {
+		return err
+	}
+
+	if !supported {
+		return nil
+	}
+
+	if suspended {
+		j.object.AddButton("Resume", action.CreatePayload(octant.ActionOverviewResumeJob, key.ToActionPayload()))
+	} else {
+		j.object.AddButton("Suspend", action.CreatePayload(octant.ActionOverviewSuspendJob, key.ToActionPayload()))
+	}
+
+	j.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createJobSuspendView(suspended), nil
+		},
+	})
+
+	return nil
+}
+
+// jobSuspendState returns the value of a job's spec.suspend. The batch/v1 types do not
+// include the field, so it is read from the cached object. If the field is not set,
+// suspension is not supported.
+func jobSuspendState(ctx context.Context, key store.Key, options Options) (suspended bool, supported bool, err error) {
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return false, false, errors.Wrap(err, "get job")
+	}
+
+	if object == nil {
+		return false, false, nil
+	}
+
+	suspended, found, err := unstructured.NestedBool(object.Object, "spec", "suspend")
+	if err != nil || !found {
+		return false, false, nil
+	}
+
+	return suspended, true, nil
+}
+
+func createJobSuspendView(suspended bool) *component.Summary {
+	sections := component.SummarySections{}
+	sections.AddText("Suspended", fmt.Sprintf("%t", suspended))
+
+	summary := component.NewSummary("Suspension", sections...)
+	if suspended {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			"Job is suspended and will not start pods until it is resumed"))
+	}
+
+	return summary
+}
+
 func (j *jobHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
 	j.object.EnablePodTemplate(j.job.Spec.Template)
 
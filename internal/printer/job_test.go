@@ -16,9 +16,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -70,6 +72,7 @@ func Test_JobListHandler(t *testing.T) {
 				"Job is in progress",
 			})),
 		"Labels":      component.NewLabels(validJobLabels),
+		"Status":      component.NewStatusBadges(""),
 		"Completions": component.NewText("1"),
 		"Successful":  component.NewText("1"),
 		"Age":         component.NewTimestamp(validJobCreationTime),
@@ -81,6 +84,61 @@ func Test_JobListHandler(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_jobStatusBadges(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   batchv1.JobStatus
+		expected []component.StatusBadge
+	}{
+		{
+			name:     "no conditions",
+			expected: []component.StatusBadge{},
+		},
+		{
+			name:   "running",
+			status: batchv1.JobStatus{Active: 2},
+			expected: []component.StatusBadge{
+				{Label: "Running", Message: "2 active"},
+			},
+		},
+		{
+			name: "complete",
+			status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+				},
+			},
+			expected: []component.StatusBadge{
+				{Label: "Complete", Status: component.TextStatusOK},
+			},
+		},
+		{
+			name: "failed",
+			status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobComplete, Status: corev1.ConditionFalse},
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+				},
+			},
+			expected: []component.StatusBadge{
+				{Label: "Failed (BackoffLimitExceeded)", Status: component.TextStatusError, Message: "Job has reached the specified backoff limit"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job := testutil.CreateJob("job")
+			job.Status = test.status
+
+			got := jobStatusBadges(job)
+
+			expected := component.NewStatusBadges("", test.expected...)
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
+
 func Test_JobConfiguration(t *testing.T) {
 	var backofflimit int32 = 4
 	var completions int32 = 1
@@ -163,6 +221,95 @@ func Test_createJobStatus(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func Test_createJobStatus_activeDeadline(t *testing.T) {
+	job := testutil.CreateJob("job")
+	job.Spec.ActiveDeadlineSeconds = conversion.PtrInt64(600)
+	job.Status.StartTime = &metav1.Time{Time: testutil.Time()}
+
+	got, err := createJobStatus(*job)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{
+		{Header: "Started", Content: component.NewTimestamp(testutil.Time())},
+		{Header: "Active Deadline", Content: component.NewCountdown(testutil.Time().Add(10*time.Minute),
+			component.WithCountdownThresholds(10*time.Minute, time.Minute))},
+		{Header: "Succeeded", Content: component.NewText("0")},
+	}
+	expected := component.NewSummary("Status", sections...)
+
+	assert.Equal(t, expected, got)
+}
+
+func Test_jobSuspendState(t *testing.T) {
+	tests := []struct {
+		name              string
+		suspend           interface{}
+		expectedSuspended bool
+		expectedSupported bool
+	}{
+		{
+			name:              "suspended",
+			suspend:           true,
+			expectedSuspended: true,
+			expectedSupported: true,
+		},
+		{
+			name:              "not suspended",
+			suspend:           false,
+			expectedSupported: true,
+		},
+		{
+			name: "api version without suspend",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			job := testutil.ToUnstructured(t, testutil.CreateJob("job"))
+			if test.suspend != nil {
+				require.NoError(t, unstructured.SetNestedField(job.Object, test.suspend, "spec", "suspend"))
+			}
+
+			key, err := store.KeyFromObject(job)
+			require.NoError(t, err)
+
+			tpo.objectStore.EXPECT().Get(ctx, key).Return(job, nil)
+
+			suspended, supported, err := jobSuspendState(ctx, key, tpo.ToOptions())
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedSuspended, suspended)
+			assert.Equal(t, test.expectedSupported, supported)
+		})
+	}
+}
+
+func Test_createJobSuspendView(t *testing.T) {
+	got := createJobSuspendView(true)
+
+	expected := component.NewSummary("Suspension", component.SummarySection{
+		Header: "Suspended", Content: component.NewText("true"),
+	})
+	expected.SetAlert(component.NewAlert(component.AlertTypeWarning,
+		"Job is suspended and will not start pods until it is resumed"))
+
+	component.AssertEqual(t, expected, got)
+
+	got = createJobSuspendView(false)
+
+	expected = component.NewSummary("Suspension", component.SummarySection{
+		Header: "Suspended", Content: component.NewText("false"),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
 func Test_createJobConditions(t *testing.T) {
 	now := metav1.Time{Time: time.Now()}
 
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+const (
+	// kubeletRequestTimeout bounds each request to a kubelet endpoint.
+	kubeletRequestTimeout = 5 * time.Second
+	// kubeletCacheTTL is how long a kubelet endpoint's response, or error, is reused.
+	kubeletCacheTTL = time.Minute
+)
+
+// kubeletGetFunc gets a kubelet endpoint, e.g. "stats/summary", of a node.
+type kubeletGetFunc func(ctx context.Context, nodeName, endpoint string) ([]byte, error)
+
+// kubeletStatsSummary is the part of the kubelet's stats summary which contains container
+// log stats.
+type kubeletStatsSummary struct {
+	Pods []struct {
+		PodRef struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"podRef"`
+		Containers []struct {
+			Name string `json:"name"`
+			Logs *struct {
+				UsedBytes *uint64 `json:"usedBytes"`
+			} `json:"logs"`
+		} `json:"containers"`
+	} `json:"pods"`
+}
+
+// kubeletConfigz is the part of the kubelet's configz which configures log rotation.
+type kubeletConfigz struct {
+	KubeletConfig struct {
+		ContainerLogMaxSize string `json:"containerLogMaxSize"`
+	} `json:"kubeletconfig"`
+}
+
+// NewKubeletContainerLogStats creates a ContainerLogStatsFunc which reads container log
+// sizes from the stats summary of the pod's node, proxied through the API server. Logs
+// are rotated if the node's kubelet sets a maximum container log size. Responses are cached
+// for each node, so it is opt-in: use Resource.SetContainerLogStats to print log sizes.
+func NewKubeletContainerLogStats(dashConfig config.Dash) ContainerLogStatsFunc {
+	get := func(ctx context.Context, nodeName, endpoint string) ([]byte, error) {
+		client, err := dashConfig.ClusterClient().KubernetesClient()
+		if err != nil {
+			return nil, errors.Wrap(err, "get kubernetes client")
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, kubeletRequestTimeout)
+		defer cancel()
+
+		return client.CoreV1().RESTClient().Get().
+			Resource("nodes").
+			Name(nodeName).
+			SubResource("proxy").
+			Suffix(endpoint).
+			DoRaw(ctx)
+	}
+
+	return kubeletContainerLogStats(cachedKubeletGet(get, clock.RealClock{}, kubeletCacheTTL))
+}
+
+type kubeletCacheEntry struct {
+	data    []byte
+	err     error
+	expires time.Time
+}
+
+// cachedKubeletGet reuses the responses of get, including errors, for ttl.
+func cachedKubeletGet(get kubeletGetFunc, c clock.Clock, ttl time.Duration) kubeletGetFunc {
+	var mu sync.Mutex
+	entries := make(map[string]kubeletCacheEntry)
+
+	return func(ctx context.Context, nodeName, endpoint string) ([]byte, error) {
+		key := nodeName + "/" + endpoint
+
+		mu.Lock()
+		entry, ok := entries[key]
+		mu.Unlock()
+		if ok && c.Now().Before(entry.expires) {
+			return entry.data, entry.err
+		}
+
+		data, err := get(ctx, nodeName, endpoint)
+
+		mu.Lock()
+		entries[key] = kubeletCacheEntry{data: data, err: err, expires: c.Now().Add(ttl)}
+		mu.Unlock()
+
+		return data, err
+	}
+}
+
+// kubeletContainerLogStats reads container log stats with get. If node proxy access is
+// forbidden, there are no stats, and that is logged once.
+func kubeletContainerLogStats(get kubeletGetFunc) ContainerLogStatsFunc {
+	var forbidden sync.Once
+
+	return func(ctx context.Context, pod *corev1.Pod) (map[string]ContainerLogStats, error) {
+		if pod == nil || pod.Spec.NodeName == "" {
+			return nil, nil
+		}
+
+		data, err := get(ctx, pod.Spec.NodeName, "stats/summary")
+		if kerrors.IsForbidden(err) {
+			forbidden.Do(func() {
+				log.From(ctx).Debugf("container log stats are unavailable: %s", err)
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "get stats summary for node %s", pod.Spec.NodeName)
+		}
+
+		var summary kubeletStatsSummary
+		if err := json.Unmarshal(data, &summary); err != nil {
+			return nil, errors.Wrapf(err, "decode stats summary for node %s", pod.Spec.NodeName)
+		}
+
+		rotated := false
+		if data, err := get(ctx, pod.Spec.NodeName, "configz"); err != nil {
+			log.From(ctx).Debugf("get kubelet config for node %s: %s", pod.Spec.NodeName, err)
+		} else {
+			var configz kubeletConfigz
+			if err := json.Unmarshal(data, &configz); err != nil {
+				return nil, errors.Wrapf(err, "decode kubelet config for node %s", pod.Spec.NodeName)
+			}
+			rotated = configz.KubeletConfig.ContainerLogMaxSize != ""
+		}
+
+		stats := make(map[string]ContainerLogStats)
+		for _, podStats := range summary.Pods {
+			if podStats.PodRef.Name != pod.Name || podStats.PodRef.Namespace != pod.Namespace {
+				continue
+			}
+
+			for _, containerStats := range podStats.Containers {
+				if containerStats.Logs == nil || containerStats.Logs.UsedBytes == nil {
+					continue
+				}
+
+				stats[containerStats.Name] = ContainerLogStats{
+					UsedBytes: int64(*containerStats.Logs.UsedBytes),
+					Rotated:   rotated,
+				}
+			}
+		}
+
+		return stats, nil
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+func Test_kubeletContainerLogStats(t *testing.T) {
+	summary := `{"pods": [
+		{"podRef": {"name": "pod", "namespace": "namespace"}, "containers": [
+			{"name": "app", "logs": {"usedBytes": 2048}},
+			{"name": "sidecar"}
+		]},
+		{"podRef": {"name": "other", "namespace": "namespace"}, "containers": [
+			{"name": "app", "logs": {"usedBytes": 1024}}
+		]}
+	]}`
+
+	tests := []struct {
+		name      string
+		nodeName  string
+		endpoints map[string]string
+		expected  map[string]ContainerLogStats
+		isErr     bool
+	}{
+		{
+			name: "pod is not scheduled",
+		},
+		{
+			name:     "rotated logs",
+			nodeName: "node",
+			endpoints: map[string]string{
+				"stats/summary": summary,
+				"configz":       `{"kubeletconfig": {"containerLogMaxSize": "10Mi"}}`,
+			},
+			expected: map[string]ContainerLogStats{
+				"app": {UsedBytes: 2048, Rotated: true},
+			},
+		},
+		{
+			name:     "logs are not rotated",
+			nodeName: "node",
+			endpoints: map[string]string{
+				"stats/summary": summary,
+				"configz":       `{"kubeletconfig": {}}`,
+			},
+			expected: map[string]ContainerLogStats{
+				"app": {UsedBytes: 2048},
+			},
+		},
+		{
+			name:     "kubelet config is unavailable",
+			nodeName: "node",
+			endpoints: map[string]string{
+				"stats/summary": summary,
+			},
+			expected: map[string]ContainerLogStats{
+				"app": {UsedBytes: 2048},
+			},
+		},
+		{
+			name:     "node proxy access is forbidden",
+			nodeName: "node",
+		},
+		{
+			name:     "stats summary is unavailable",
+			nodeName: "node",
+			endpoints: map[string]string{
+				"configz": `{"kubeletconfig": {}}`,
+			},
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := testutil.CreatePod("pod")
+			pod.Spec.NodeName = test.nodeName
+
+			get := func(ctx context.Context, nodeName, endpoint string) ([]byte, error) {
+				require.Equal(t, "node", nodeName)
+
+				if test.endpoints == nil {
+					return nil, kerrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, nodeName, errors.New("forbidden"))
+				}
+
+				data, ok := test.endpoints[endpoint]
+				if !ok {
+					return nil, errors.New("not found")
+				}
+				return []byte(data), nil
+			}
+
+			got, err := kubeletContainerLogStats(get)(context.Background(), pod)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_cachedKubeletGet(t *testing.T) {
+	calls := 0
+	get := func(ctx context.Context, nodeName, endpoint string) ([]byte, error) {
+		calls++
+		return []byte(nodeName + "/" + endpoint), nil
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	cached := cachedKubeletGet(get, fakeClock, time.Minute)
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		data, err := cached(ctx, "node", "stats/summary")
+		require.NoError(t, err)
+		require.Equal(t, "node/stats/summary", string(data))
+	}
+	require.Equal(t, 1, calls)
+
+	_, err := cached(ctx, "node", "configz")
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	fakeClock.Step(time.Minute)
+
+	_, err = cached(ctx, "node", "stats/summary")
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
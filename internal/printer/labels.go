@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// createLabelsView creates a labels component. If options.LabelsClickable is set, each label
+// links to the list of the object's kind filtered by that label. Labels are printed without
+// links if the object's list path can't be resolved.
+func createLabelsView(object runtime.Object, labels map[string]string, options Options) *component.Labels {
+	view := component.NewLabels(labels)
+
+	if !options.LabelsClickable || options.Link == nil || object == nil || len(labels) == 0 {
+		return view
+	}
+
+	listPath, err := labelsListPath(object, options)
+	if err != nil {
+		return view
+	}
+
+	for key, value := range labels {
+		view.AddLink(key, labelFilterRef(listPath, key, value))
+	}
+
+	return view
+}
+
+// labelsListPath returns the path of the list containing an object.
+func labelsListPath(object runtime.Object, options Options) (string, error) {
+	accessor := meta.NewAccessor()
+
+	namespace, err := accessor.Namespace(object)
+	if err != nil {
+		return "", err
+	}
+
+	apiVersion, err := accessor.APIVersion(object)
+	if err != nil {
+		return "", err
+	}
+
+	kind, err := accessor.Kind(object)
+	if err != nil {
+		return "", err
+	}
+
+	if apiVersion == "" || kind == "" {
+		return "", fmt.Errorf("object has no group version kind")
+	}
+
+	listLink, err := options.Link.ForGVK(namespace, apiVersion, kind, "", "")
+	if err != nil {
+		return "", err
+	}
+
+	return listLink.Ref(), nil
+}
+
+// labelFilterRef adds a label filter to a list path. The filter value is query
+// encoded, so label keys and values containing reserved characters are preserved.
+func labelFilterRef(listPath, key, value string) string {
+	u := url.URL{Path: listPath}
+	u.RawQuery = url.Values{"filters": []string{fmt.Sprintf("%s:%s", key, value)}}.Encode()
+	return u.String()
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createLabelsView(t *testing.T) {
+	labels := map[string]string{
+		"app.kubernetes.io/name": "web",
+		"tier":                   "front end&more",
+	}
+
+	tests := []struct {
+		name      string
+		clickable bool
+		pathErr   error
+		expected  func() *component.Labels
+	}{
+		{
+			name: "labels are not clickable",
+			expected: func() *component.Labels {
+				return component.NewLabels(labels)
+			},
+		},
+		{
+			name:      "labels are clickable",
+			clickable: true,
+			expected: func() *component.Labels {
+				view := component.NewLabels(labels)
+				view.AddLink("app.kubernetes.io/name", "/pods?filters=app.kubernetes.io%2Fname%3Aweb")
+				view.AddLink("tier", "/pods?filters=tier%3Afront+end%26more")
+				return view
+			},
+		},
+		{
+			name:      "list path can't be resolved",
+			clickable: true,
+			pathErr:   errors.New("unknown object"),
+			expected: func() *component.Labels {
+				return component.NewLabels(labels)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			pod := testutil.CreatePod("pod")
+
+			if test.clickable {
+				if test.pathErr != nil {
+					tpo.link.EXPECT().ForGVK("namespace", "v1", "Pod", "", "").Return(nil, test.pathErr)
+				} else {
+					tpo.PathForGVK("namespace", "v1", "Pod", "", "", "/pods")
+				}
+			}
+
+			options := tpo.ToOptions()
+			options.LabelsClickable = test.clickable
+
+			got := createLabelsView(pod, labels, options)
+			assert.Equal(t, test.expected(), got)
+		})
+	}
+}
+
+func Test_labelFilterRef(t *testing.T) {
+	got := labelFilterRef("/overview/namespace/default/workloads/pods", "example.com/team", "a/b")
+	assert.Equal(t, "/overview/namespace/default/workloads/pods?filters=example.com%2Fteam%3Aa%2Fb", got)
+}
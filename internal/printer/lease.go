@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	leaseTableCols = component.NewTableCols("Name", "Labels", "Holder", "Expires", "Age")
+)
+
+// LeaseListHandler is a printFunc that lists leases.
+func LeaseListHandler(ctx context.Context, list *coordinationv1.LeaseList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("lease list is nil")
+	}
+
+	ot := NewObjectTable("Leases", "We couldn't find any leases!", leaseTableCols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
+
+	for i := range list.Items {
+		lease := list.Items[i]
+
+		nameLink, err := options.Link.ForObject(&lease, lease.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row := component.TableRow{}
+		row["Name"] = nameLink
+		row["Labels"] = createLabelsView(&lease, lease.Labels, options)
+		row["Holder"] = component.NewText(leaseHolder(lease))
+		row["Expires"] = leaseExpiry(lease)
+		row["Age"] = component.NewTimestamp(lease.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &lease, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// LeaseHandler is a printFunc that prints a lease.
+func LeaseHandler(ctx context.Context, lease *coordinationv1.Lease, options Options) (component.Component, error) {
+	if lease == nil {
+		return nil, errors.New("lease is nil")
+	}
+
+	o := NewObject(lease)
+
+	config, err := createLeaseConfiguration(*lease)
+	if err != nil {
+		return nil, errors.Wrap(err, "print lease configuration")
+	}
+	o.RegisterConfig(config)
+
+	return o.ToComponent(ctx, options)
+}
+
+func createLeaseConfiguration(lease coordinationv1.Lease) (*component.Summary, error) {
+	sections := component.SummarySections{}
+
+	sections.AddText("Holder", leaseHolder(lease))
+
+	if lds := lease.Spec.LeaseDurationSeconds; lds != nil {
+		sections.AddText("Lease Duration", fmt.Sprintf("%ds", *lds))
+	}
+	if acquireTime := lease.Spec.AcquireTime; acquireTime != nil {
+		sections.Add("Acquired", component.NewTimestamp(acquireTime.Time))
+	}
+	if renewTime := lease.Spec.RenewTime; renewTime != nil {
+		sections.Add("Renewed", component.NewTimestamp(renewTime.Time))
+	}
+
+	sections.Add("Expires", leaseExpiry(lease))
+
+	if transitions := lease.Spec.LeaseTransitions; transitions != nil {
+		sections.AddText("Transitions", fmt.Sprintf("%d", *transitions))
+	}
+
+	return component.NewSummary("Configuration", sections...), nil
+}
+
+func leaseHolder(lease coordinationv1.Lease) string {
+	if holder := lease.Spec.HolderIdentity; holder != nil && *holder != "" {
+		return *holder
+	}
+	return "<none>"
+}
+
+// leaseExpiry prints when a lease expires if it is not renewed. A lease is
+// flagged once half of its duration has passed without a renewal.
+func leaseExpiry(lease coordinationv1.Lease) component.Component {
+	lds := lease.Spec.LeaseDurationSeconds
+	renewTime := lease.Spec.RenewTime
+	if lds == nil || renewTime == nil {
+		return component.NewText("<unknown>")
+	}
+
+	duration := time.Duration(*lds) * time.Second
+
+	return component.NewCountdown(renewTime.Add(duration),
+		component.WithCountdownThresholds(duration/2, 0))
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createTestLease(name string) *coordinationv1.Lease {
+	renewTime := metav1.NewMicroTime(testutil.Time())
+
+	return &coordinationv1.Lease{
+		TypeMeta: metav1.TypeMeta{APIVersion: "coordination.k8s.io/v1", Kind: "Lease"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "kube-node-lease",
+			CreationTimestamp: metav1.Time{Time: testutil.Time()},
+			Labels:            map[string]string{"app": "lease"},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       conversion.PtrString("node"),
+			LeaseDurationSeconds: conversion.PtrInt32(40),
+			RenewTime:            &renewTime,
+			LeaseTransitions:     conversion.PtrInt32(2),
+		},
+	}
+}
+
+func Test_LeaseListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	lease := createTestLease("lease")
+	list := &coordinationv1.LeaseList{Items: []coordinationv1.Lease{*lease}}
+
+	tpo.PathForObject(lease, lease.Name, "/lease")
+
+	got, err := LeaseListHandler(context.Background(), list, printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Leases", "We couldn't find any leases!", leaseTableCols, []component.TableRow{
+		{
+			"Name": component.NewLink("", "lease", "/lease",
+				genObjectStatus(component.TextStatusOK, []string{"coordination.k8s.io/v1 Lease is OK"})),
+			"Labels": component.NewLabels(lease.Labels),
+			"Holder": component.NewText("node"),
+			"Expires": component.NewCountdown(testutil.Time().Add(40*time.Second),
+				component.WithCountdownThresholds(20*time.Second, 0)),
+			"Age": component.NewTimestamp(testutil.Time()),
+			component.GridActionKey: gridActionsFactory([]component.GridAction{
+				buildObjectDeleteAction(t, lease),
+			}),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createLeaseConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		lease    func() *coordinationv1.Lease
+		expected component.SummarySections
+	}{
+		{
+			name: "held lease",
+			lease: func() *coordinationv1.Lease {
+				return createTestLease("lease")
+			},
+			expected: component.SummarySections{
+				{Header: "Holder", Content: component.NewText("node")},
+				{Header: "Lease Duration", Content: component.NewText("40s")},
+				{Header: "Renewed", Content: component.NewTimestamp(testutil.Time())},
+				{Header: "Expires", Content: component.NewCountdown(testutil.Time().Add(40*time.Second),
+					component.WithCountdownThresholds(20*time.Second, 0))},
+				{Header: "Transitions", Content: component.NewText("2")},
+			},
+		},
+		{
+			name: "lease without holder",
+			lease: func() *coordinationv1.Lease {
+				lease := createTestLease("lease")
+				lease.Spec = coordinationv1.LeaseSpec{}
+				return lease
+			},
+			expected: component.SummarySections{
+				{Header: "Holder", Content: component.NewText("<none>")},
+				{Header: "Expires", Content: component.NewText("<unknown>")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := createLeaseConfiguration(*test.lease())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, component.NewSummary("Configuration", test.expected...), got)
+		})
+	}
+}
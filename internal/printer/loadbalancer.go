@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// loadBalancerProvisioningTimeout is how long a load balancer service can be without
+	// ingress points before it is flagged as stuck provisioning.
+	loadBalancerProvisioningTimeout = 5 * time.Minute
+)
+
+var (
+	loadBalancerIngressCols = component.NewTableCols("Address", "Type", "IP Mode", "Ports")
+)
+
+// loadBalancerIngress is an entry in a service's status.loadBalancer.ingress.
+type loadBalancerIngress struct {
+	ip       string
+	hostname string
+	ipMode   string
+	ports    []loadBalancerPortStatus
+}
+
+// loadBalancerPortStatus is the status of a port on a load balancer ingress point.
+type loadBalancerPortStatus struct {
+	port     int64
+	protocol string
+	err      string
+}
+
+func (s loadBalancerPortStatus) String() string {
+	out := fmt.Sprintf("%d/%s", s.port, s.protocol)
+	if s.err != "" {
+		out = fmt.Sprintf("%s (%s)", out, s.err)
+	}
+	return out
+}
+
+// createServiceLoadBalancerView prints the published status of a load balancer service:
+// its provisioning state, ingress points with their port status, and status conditions.
+// Ingress port status and conditions are not part of the core/v1 types, so they are read
+// from the cached service. Services which are not load balancers have no view.
+func createServiceLoadBalancerView(ctx context.Context, service *corev1.Service, now time.Time, options Options) (component.Component, error) {
+	if service == nil {
+		return nil, errors.New("service is nil")
+	}
+
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil, nil
+	}
+
+	key := store.Key{
+		Namespace:  service.Namespace,
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       service.Name,
+	}
+
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get service")
+	}
+
+	if object == nil {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(service)
+		if err != nil {
+			return nil, err
+		}
+		object = &unstructured.Unstructured{Object: m}
+	}
+
+	ingresses := serviceLoadBalancerIngresses(object)
+
+	var sections component.SummarySections
+	var alert *component.Alert
+
+	status := component.NewText("Provisioned")
+	status.SetStatus(component.TextStatusOK)
+
+	if len(ingresses) == 0 {
+		age := now.Sub(service.CreationTimestamp.Time)
+		if age > loadBalancerProvisioningTimeout {
+			status = component.NewText(fmt.Sprintf("Provisioning for %s", duration.HumanDuration(age)))
+			status.SetStatus(component.TextStatusError)
+
+			a := component.NewAlert(component.AlertTypeWarning,
+				fmt.Sprintf("Load balancer has no ingress points after %s", duration.HumanDuration(age)))
+			alert = &a
+		} else {
+			status = component.NewText("Provisioning")
+			status.SetStatus(component.TextStatusWarning)
+		}
+	}
+
+	sections.Add("Status", status)
+
+	if len(ingresses) > 0 {
+		table := component.NewTable("Ingress", "Load balancer has no ingress points", loadBalancerIngressCols)
+		for _, ingress := range ingresses {
+			table.Add(loadBalancerIngressRow(ingress))
+		}
+		sections.Add("Ingress", table)
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		sections.Add(conditionType, loadBalancerCondition(condition))
+	}
+
+	summary := component.NewSummary("Load Balancer", sections...)
+	if alert != nil {
+		summary.SetAlert(*alert)
+	}
+
+	return summary, nil
+}
+
+// serviceLoadBalancerIngresses returns the ingress points in a service's load balancer status.
+func serviceLoadBalancerIngresses(object *unstructured.Unstructured) []loadBalancerIngress {
+	entries, _, _ := unstructured.NestedSlice(object.Object, "status", "loadBalancer", "ingress")
+
+	var ingresses []loadBalancerIngress
+	for _, entry := range entries {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var ingress loadBalancerIngress
+		ingress.ip, _, _ = unstructured.NestedString(m, "ip")
+		ingress.hostname, _, _ = unstructured.NestedString(m, "hostname")
+		ingress.ipMode, _, _ = unstructured.NestedString(m, "ipMode")
+
+		ports, _, _ := unstructured.NestedSlice(m, "ports")
+		for _, p := range ports {
+			pm, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var portStatus loadBalancerPortStatus
+			portStatus.port, _, _ = unstructured.NestedInt64(pm, "port")
+			portStatus.protocol, _, _ = unstructured.NestedString(pm, "protocol")
+			if errorString, found, _ := unstructured.NestedString(pm, "error"); found {
+				portStatus.err = errorString
+			}
+
+			ingress.ports = append(ingress.ports, portStatus)
+		}
+
+		ingresses = append(ingresses, ingress)
+	}
+
+	return ingresses
+}
+
+// loadBalancerIngressRow prints an ingress point. Hostnames link to the host, and IPs are
+// printed so they can be copied.
+func loadBalancerIngressRow(ingress loadBalancerIngress) component.TableRow {
+	row := component.TableRow{}
+
+	switch {
+	case ingress.hostname != "":
+		row["Address"] = component.NewLink("", ingress.hostname, "http://"+ingress.hostname)
+		row["Type"] = component.NewText("Hostname")
+	default:
+		row["Address"] = component.NewCodeBlock(ingress.ip)
+		row["Type"] = component.NewText("IP")
+	}
+
+	ipMode := ingress.ipMode
+	if ipMode == "" {
+		ipMode = "<none>"
+	}
+	row["IP Mode"] = component.NewText(ipMode)
+
+	if len(ingress.ports) == 0 {
+		row["Ports"] = component.NewText("<none>")
+		return row
+	}
+
+	var ports []string
+	hasError := false
+	for _, port := range ingress.ports {
+		ports = append(ports, port.String())
+		if port.err != "" {
+			hasError = true
+		}
+	}
+
+	text := component.NewText(strings.Join(ports, ", "))
+	if hasError {
+		text.SetStatus(component.TextStatusError)
+	} else {
+		text.SetStatus(component.TextStatusOK)
+	}
+	row["Ports"] = text
+
+	return row
+}
+
+// loadBalancerCondition prints a service status condition. Error conditions which are
+// true are shown as errors.
+func loadBalancerCondition(condition map[string]interface{}) *component.Text {
+	conditionType, _, _ := unstructured.NestedString(condition, "type")
+	status, _, _ := unstructured.NestedString(condition, "status")
+	reason, _, _ := unstructured.NestedString(condition, "reason")
+	message, _, _ := unstructured.NestedString(condition, "message")
+
+	out := status
+	if reason != "" {
+		out = fmt.Sprintf("%s (%s)", out, reason)
+	}
+	if message != "" {
+		out = fmt.Sprintf("%s: %s", out, message)
+	}
+
+	text := component.NewText(out)
+	if strings.HasSuffix(conditionType, "Error") && status == string(corev1.ConditionTrue) {
+		text.SetStatus(component.TextStatusError)
+	}
+
+	return text
+}
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createServiceLoadBalancerView(t *testing.T) {
+	now := testutil.Time()
+
+	statusText := func(value string, status component.TextStatus) *component.Text {
+		text := component.NewText(value)
+		text.SetStatus(status)
+		return text
+	}
+
+	tests := []struct {
+		name        string
+		serviceType corev1.ServiceType
+		age         time.Duration
+		notFound    bool
+		status      map[string]interface{}
+		expected    func() component.Component
+	}{
+		{
+			name:        "not a load balancer",
+			serviceType: corev1.ServiceTypeClusterIP,
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:        "provisioning",
+			serviceType: corev1.ServiceTypeLoadBalancer,
+			age:         time.Minute,
+			notFound:    true,
+			expected: func() component.Component {
+				return component.NewSummary("Load Balancer", component.SummarySections{
+					{Header: "Status", Content: statusText("Provisioning", component.TextStatusWarning)},
+				}...)
+			},
+		},
+		{
+			name:        "stuck provisioning",
+			serviceType: corev1.ServiceTypeLoadBalancer,
+			age:         time.Hour,
+			expected: func() component.Component {
+				summary := component.NewSummary("Load Balancer", component.SummarySections{
+					{Header: "Status", Content: statusText("Provisioning for 60m", component.TextStatusError)},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Load balancer has no ingress points after 60m"))
+				return summary
+			},
+		},
+		{
+			name:        "ingress points and conditions",
+			serviceType: corev1.ServiceTypeLoadBalancer,
+			age:         time.Hour,
+			status: map[string]interface{}{
+				"loadBalancer": map[string]interface{}{
+					"ingress": []interface{}{
+						map[string]interface{}{
+							"ip":     "192.0.2.10",
+							"ipMode": "VIP",
+							"ports": []interface{}{
+								map[string]interface{}{"port": int64(80), "protocol": "TCP"},
+							},
+						},
+						map[string]interface{}{
+							"hostname": "lb.example.com",
+							"ports": []interface{}{
+								map[string]interface{}{"port": int64(80), "protocol": "TCP"},
+								map[string]interface{}{"port": int64(53), "protocol": "UDP", "error": "UDPNotSupported"},
+							},
+						},
+					},
+				},
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":    "LoadBalancerPortsError",
+						"status":  "True",
+						"reason":  "UDPNotSupported",
+						"message": "port 53/UDP is not supported",
+					},
+				},
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Ingress", "Load balancer has no ingress points", loadBalancerIngressCols)
+				table.Add(
+					component.TableRow{
+						"Address": component.NewCodeBlock("192.0.2.10"),
+						"Type":    component.NewText("IP"),
+						"IP Mode": component.NewText("VIP"),
+						"Ports":   statusText("80/TCP", component.TextStatusOK),
+					},
+					component.TableRow{
+						"Address": component.NewLink("", "lb.example.com", "http://lb.example.com"),
+						"Type":    component.NewText("Hostname"),
+						"IP Mode": component.NewText("<none>"),
+						"Ports":   statusText("80/TCP, 53/UDP (UDPNotSupported)", component.TextStatusError),
+					},
+				)
+
+				return component.NewSummary("Load Balancer", component.SummarySections{
+					{Header: "Status", Content: statusText("Provisioned", component.TextStatusOK)},
+					{Header: "Ingress", Content: table},
+					{
+						Header:  "LoadBalancerPortsError",
+						Content: statusText("True (UDPNotSupported): port 53/UDP is not supported", component.TextStatusError),
+					},
+				}...)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			service := testutil.CreateService("service")
+			service.Spec.Type = test.serviceType
+			service.CreationTimestamp = metav1.NewTime(now.Add(-test.age))
+
+			if test.serviceType == corev1.ServiceTypeLoadBalancer {
+				key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Service", Name: "service"}
+				if test.notFound {
+					tpo.objectStore.EXPECT().Get(ctx, key).Return(nil, nil)
+				} else {
+					object := testutil.ToUnstructured(t, service)
+					for k, v := range test.status {
+						require.NoError(t, unstructured.SetNestedField(object.Object, v, "status", k))
+					}
+					tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+				}
+			}
+
+			got, err := createServiceLoadBalancerView(ctx, service, now, tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
@@ -7,10 +7,12 @@ package printer
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 	"github.com/vmware-tanzu/octant/pkg/view/flexlayout"
@@ -91,6 +93,7 @@ func (m *Metadata) createSummary() (*component.Summary, error) {
 	}
 
 	sections.Add("Age", component.NewTimestamp(object.GetCreationTimestamp().Time))
+	sections.AddText("Scope", objectScope(m.object, object))
 
 	if labels := object.GetLabels(); len(labels) > 0 {
 		sections.Add("Labels", component.NewLabels(labels))
@@ -118,3 +121,94 @@ func (m *Metadata) createSummary() (*component.Summary, error) {
 	summary := component.NewSummary("Metadata", sections...)
 	return summary, nil
 }
+
+// objectScope returns "Cluster" for cluster scoped objects and "Namespaced" for namespaced
+// objects. Objects are cluster scoped if their kind is known to be cluster scoped or they
+// aren't in a namespace.
+func objectScope(object runtime.Object, metaObject metav1.Object) string {
+	apiVersion, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+	if gvk.IsClusterScoped(apiVersion, kind) || metaObject.GetNamespace() == "" {
+		return "Cluster"
+	}
+	return "Namespaced"
+}
+
+// createMetadataSection creates a summary containing an object's metadata. Empty fields are
+// printed as "<none>" unless options.HideEmpty is set, and annotations matching
+// options.HiddenAnnotations are not printed.
+func createMetadataSection(object metav1.Object, options Options) *component.Summary {
+	sections := component.SummarySections{}
+
+	if object == nil {
+		return component.NewSummary("Metadata", sections...)
+	}
+
+	addText := func(header, value string) {
+		if value == "" {
+			if options.HideEmpty {
+				return
+			}
+			value = "<none>"
+		}
+		sections.AddText(header, value)
+	}
+
+	addText("Name", object.GetName())
+	addText("Namespace", object.GetNamespace())
+	addText("UID", string(object.GetUID()))
+
+	if creationTimestamp := object.GetCreationTimestamp(); !creationTimestamp.IsZero() {
+		sections.Add("Created", component.NewTimestamp(creationTimestamp.Time))
+	} else {
+		addText("Created", "")
+	}
+
+	if generation := object.GetGeneration(); generation > 0 {
+		sections.AddText("Generation", fmt.Sprintf("%d", generation))
+	} else {
+		addText("Generation", "")
+	}
+
+	if labels := object.GetLabels(); len(labels) > 0 {
+		runtimeObject, _ := object.(runtime.Object)
+		sections.Add("Labels", createLabelsView(runtimeObject, labels, options))
+	} else {
+		addText("Labels", "")
+	}
+
+	if annotations := filterAnnotations(object.GetAnnotations(), options.HiddenAnnotations); len(annotations) > 0 {
+		sections.Add("Annotations", component.NewAnnotations(annotations))
+	} else {
+		addText("Annotations", "")
+	}
+
+	return component.NewSummary("Metadata", sections...)
+}
+
+// filterAnnotations removes hidden annotations. A hidden annotation ending in "/" matches
+// every annotation with that prefix.
+func filterAnnotations(annotations map[string]string, hidden []string) map[string]string {
+	if len(hidden) == 0 {
+		return annotations
+	}
+
+	filtered := make(map[string]string)
+
+	for key, value := range annotations {
+		if isHiddenAnnotation(key, hidden) {
+			continue
+		}
+		filtered[key] = value
+	}
+
+	return filtered
+}
+
+func isHiddenAnnotation(key string, hidden []string) bool {
+	for _, h := range hidden {
+		if key == h || (strings.HasSuffix(h, "/") && strings.HasPrefix(key, h)) {
+			return true
+		}
+	}
+	return false
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -18,35 +20,142 @@ import (
 )
 
 func Test_Metadata(t *testing.T) {
-	controller := gomock.NewController(t)
-	defer controller.Finish()
+	tests := []struct {
+		name          string
+		object        runtime.Object
+		expectedScope string
+	}{
+		{
+			name:          "namespaced object",
+			object:        testutil.CreateDeployment("deployment"),
+			expectedScope: "Namespaced",
+		},
+		{
+			name:          "cluster scoped object",
+			object:        testutil.CreateNode("node"),
+			expectedScope: "Cluster",
+		},
+	}
 
-	tpo := newTestPrinterOptions(controller)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
 
-	fl := flexlayout.New()
+			tpo := newTestPrinterOptions(controller)
 
-	deployment := testutil.CreateDeployment("deployment")
-	metadata, err := NewMetadata(deployment, tpo.link)
-	require.NoError(t, err)
+			fl := flexlayout.New()
 
-	require.NoError(t, metadata.AddToFlexLayout(fl))
+			metadata, err := NewMetadata(test.object, tpo.link)
+			require.NoError(t, err)
 
-	got := fl.ToComponent("Summary")
+			require.NoError(t, metadata.AddToFlexLayout(fl))
 
-	expected := component.NewFlexLayout("Summary")
-	expected.AddSections([]component.FlexLayoutSection{
-		{
-			{
-				Width: component.WidthFull,
-				View: component.NewSummary("Metadata", component.SummarySections{
+			got := fl.ToComponent("Summary")
+
+			object, ok := test.object.(metav1.Object)
+			require.True(t, ok)
+
+			expected := component.NewFlexLayout("Summary")
+			expected.AddSections([]component.FlexLayoutSection{
+				{
 					{
-						Header:  "Age",
-						Content: component.NewTimestamp(deployment.CreationTimestamp.Time),
+						Width: component.WidthFull,
+						View: component.NewSummary("Metadata", component.SummarySections{
+							{
+								Header:  "Age",
+								Content: component.NewTimestamp(object.GetCreationTimestamp().Time),
+							},
+							{
+								Header:  "Scope",
+								Content: component.NewText(test.expectedScope),
+							},
+						}...),
 					},
-				}...),
+				},
+			}...)
+
+			assert.Equal(t, expected, got)
+		})
+	}
+}
+
+func Test_createMetadataSection(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.CreationTimestamp = *testutil.CreateTimestamp()
+	deployment.Generation = 2
+	deployment.Labels = map[string]string{"app": "app"}
+	deployment.Annotations = map[string]string{
+		"deployment.kubernetes.io/revision":                "1",
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+		"example.com/owner":                                "team",
+	}
+
+	clusterRole := testutil.CreateClusterRole("cluster-role")
+
+	tests := []struct {
+		name     string
+		object   metav1.Object
+		options  Options
+		expected component.SummarySections
+	}{
+		{
+			name:   "all metadata",
+			object: deployment,
+			expected: component.SummarySections{
+				{Header: "Name", Content: component.NewText("deployment")},
+				{Header: "Namespace", Content: component.NewText("namespace")},
+				{Header: "UID", Content: component.NewText("deployment")},
+				{Header: "Created", Content: component.NewTimestamp(deployment.CreationTimestamp.Time)},
+				{Header: "Generation", Content: component.NewText("2")},
+				{Header: "Labels", Content: component.NewLabels(deployment.Labels)},
+				{Header: "Annotations", Content: component.NewAnnotations(deployment.Annotations)},
+			},
+		},
+		{
+			name:   "hidden annotations",
+			object: deployment,
+			options: Options{
+				HiddenAnnotations: []string{"kubectl.kubernetes.io/", "deployment.kubernetes.io/revision"},
+			},
+			expected: component.SummarySections{
+				{Header: "Name", Content: component.NewText("deployment")},
+				{Header: "Namespace", Content: component.NewText("namespace")},
+				{Header: "UID", Content: component.NewText("deployment")},
+				{Header: "Created", Content: component.NewTimestamp(deployment.CreationTimestamp.Time)},
+				{Header: "Generation", Content: component.NewText("2")},
+				{Header: "Labels", Content: component.NewLabels(deployment.Labels)},
+				{Header: "Annotations", Content: component.NewAnnotations(map[string]string{"example.com/owner": "team"})},
+			},
+		},
+		{
+			name:    "hide empty fields",
+			object:  clusterRole,
+			options: Options{HideEmpty: true},
+			expected: component.SummarySections{
+				{Header: "Name", Content: component.NewText("cluster-role")},
+				{Header: "UID", Content: component.NewText("cluster-role")},
+			},
+		},
+		{
+			name:   "empty fields",
+			object: clusterRole,
+			expected: component.SummarySections{
+				{Header: "Name", Content: component.NewText("cluster-role")},
+				{Header: "Namespace", Content: component.NewText("<none>")},
+				{Header: "UID", Content: component.NewText("cluster-role")},
+				{Header: "Created", Content: component.NewText("<none>")},
+				{Header: "Generation", Content: component.NewText("<none>")},
+				{Header: "Labels", Content: component.NewText("<none>")},
+				{Header: "Annotations", Content: component.NewText("<none>")},
 			},
 		},
-	}...)
+	}
 
-	assert.Equal(t, expected, got)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := createMetadataSection(test.object, test.options)
+			component.AssertEqual(t, component.NewSummary("Metadata", test.expected...), got)
+		})
+	}
 }
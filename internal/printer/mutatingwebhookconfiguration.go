@@ -24,6 +24,7 @@ func MutatingWebhookConfigurationListHandler(ctx context.Context, list *admissio
 
 	cols := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Mutating Webhook Configurations", "We couldn't find any mutating webhook configurations!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, mutatingWebhookConfiguration := range list.Items {
 		row := component.TableRow{}
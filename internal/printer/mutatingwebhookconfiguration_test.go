@@ -191,8 +191,10 @@ func Test_NewMutatingWebhook(t *testing.T) {
 					Content: component.NewText("Equivalent"),
 				},
 				{
-					Header:  "Side Effects",
-					Content: component.NewText("Unknown"),
+					Header: "Side Effects",
+					Content: component.NewText("Unknown (dry run requests will be rejected)", func(t *component.Text) {
+						t.SetStatus(component.TextStatusWarning)
+					}),
 				},
 				{
 					Header:  "Timeout",
@@ -200,7 +202,7 @@ func Test_NewMutatingWebhook(t *testing.T) {
 				},
 				{
 					Header:  "Admission Review Versions",
-					Content: component.NewMarkdownText("null\n"),
+					Content: component.NewText("v1beta1"),
 				},
 			}...),
 		},
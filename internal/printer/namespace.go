@@ -31,6 +31,7 @@ func NamespaceListHandler(ctx context.Context, list *corev1.NamespaceList, optio
 	}
 
 	ot := NewObjectTable("Namespaces", "We couldn't find any namespaces!", namespaceListCols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, namespace := range list.Items {
 		row := component.TableRow{}
@@ -58,6 +59,9 @@ func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options
 	if err := nh.Status(options); err != nil {
 		return nil, errors.Wrap(err, "print namespace status")
 	}
+	if err := nh.QuotaUtilization(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print namespace quota utilization")
+	}
 	if err := nh.ResourceLimits(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print namespace resource limits")
 	}
@@ -69,16 +73,18 @@ func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options
 
 type namespaceObject interface {
 	Status(options Options) error
+	QuotaUtilization(ctx context.Context, options Options) error
 	ResourceQuotas(ctx context.Context, options Options) error
 	ResourceLimits(ctx context.Context, options Options) error
 }
 
 type namespaceHandler struct {
-	namespace          *corev1.Namespace
-	statusFunc         func(*corev1.Namespace, Options) (*component.Summary, error)
-	resourceQuotasFunc func(context.Context, *corev1.Namespace, Options) (*component.FlexLayout, error)
-	resourceLimitsFunc func(context.Context, *corev1.Namespace, Options) (*component.Table, error)
-	object             *Object
+	namespace            *corev1.Namespace
+	statusFunc           func(*corev1.Namespace, Options) (*component.Summary, error)
+	quotaUtilizationFunc func(context.Context, *corev1.Namespace, Options) (*component.Summary, error)
+	resourceQuotasFunc   func(context.Context, *corev1.Namespace, Options) (*component.FlexLayout, error)
+	resourceLimitsFunc   func(context.Context, *corev1.Namespace, Options) (*component.Table, error)
+	object               *Object
 }
 
 var _ namespaceObject = (*namespaceHandler)(nil)
@@ -93,11 +99,12 @@ func newNamespaceHandler(namespace *corev1.Namespace, object *Object) (*namespac
 	}
 
 	nh := &namespaceHandler{
-		namespace:          namespace,
-		statusFunc:         defaultNamespaceStatus,
-		resourceQuotasFunc: defaultNamespaceResourceQuotas,
-		resourceLimitsFunc: defaultNamespaceResourceLimits,
-		object:             object,
+		namespace:            namespace,
+		statusFunc:           defaultNamespaceStatus,
+		quotaUtilizationFunc: createNamespaceQuotaUtilizationView,
+		resourceQuotasFunc:   defaultNamespaceResourceQuotas,
+		resourceLimitsFunc:   defaultNamespaceResourceLimits,
+		object:               object,
 	}
 	return nh, nil
 }
@@ -111,6 +118,16 @@ func (n *namespaceHandler) Status(options Options) error {
 	return nil
 }
 
+func (n *namespaceHandler) QuotaUtilization(ctx context.Context, options Options) error {
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return n.quotaUtilizationFunc(ctx, n.namespace, options)
+		},
+	})
+	return nil
+}
+
 func (n *namespaceHandler) ResourceQuotas(ctx context.Context, options Options) error {
 	n.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
@@ -31,6 +31,7 @@ func NetworkPolicyListHandler(ctx context.Context, list *networkingv1.NetworkPol
 
 	cols := component.NewTableCols("Name", "Labels", "Age")
 	ot := NewObjectTable("Network Policies", "We couldn't find any network policies!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, networkPolicy := range list.Items {
 		row := component.TableRow{}
@@ -40,7 +41,7 @@ func NetworkPolicyListHandler(ctx context.Context, list *networkingv1.NetworkPol
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(networkPolicy.Labels)
+		row["Labels"] = createLabelsView(&networkPolicy, networkPolicy.Labels, options)
 		row["Age"] = component.NewTimestamp(networkPolicy.CreationTimestamp.Time)
 
 		if err := ot.AddRowForObject(ctx, &networkPolicy, row); err != nil {
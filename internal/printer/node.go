@@ -12,8 +12,12 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -24,10 +28,14 @@ const (
 
 	// nodeLabelRole specifies the role of a node
 	nodeLabelRole = "kubernetes.io/role"
+
+	// nodeUtilizationThreshold is the percentage of a node's allocatable resources
+	// which can be requested before its utilization is shown as an error.
+	nodeUtilizationThreshold = 90
 )
 
 var (
-	nodeListColumns = component.NewTableCols("Name", "Labels", "Status", "Roles", "Age", "Version")
+	nodeListColumns = component.NewTableCols("Name", "Labels", "Status", "Utilization", "Roles", "Age", "Version")
 )
 
 // NodeListHandler is a printFunc that prints nodes
@@ -37,17 +45,29 @@ func NodeListHandler(ctx context.Context, list *corev1.NodeList, options Options
 	}
 
 	table := component.NewTable("Nodes", "We couldn't find any nodes!", nodeListColumns)
+	ageFilter := newAgeFilter(options)
+	ageFilter.addBucketFilter(table)
+
+	podsByNode, err := listPodsByNode(ctx, options)
+	if err != nil {
+		log.From(ctx).Errorf("list pods for node utilization: %s", err)
+	}
 
 	for _, node := range list.Items {
 		row := component.TableRow{}
+		if !ageFilter.filterRow(node.CreationTimestamp.Time, row) {
+			continue
+		}
+
 		nameLink, err := options.Link.ForObject(&node, node.Name)
 		if err != nil {
 			return nil, err
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(node.Labels)
+		row["Labels"] = createLabelsView(&node, node.Labels, options)
 		row["Status"] = component.NewText(nodeStatusMessage(node))
+		row["Utilization"] = createNodeUtilization(node, podsByNode)
 		row["Roles"] = component.NewText(nodeRoles(node))
 		row["Age"] = component.NewTimestamp(node.CreationTimestamp.Time)
 		row["Version"] = component.NewText(node.Status.NodeInfo.KubeletVersion)
@@ -58,6 +78,78 @@ func NodeListHandler(ctx context.Context, list *corev1.NodeList, options Options
 	return table, nil
 }
 
+// listPodsByNode returns the active pods in the cache grouped by the node they are
+// scheduled on.
+func listPodsByNode(ctx context.Context, options Options) (map[string][]corev1.Pod, error) {
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	podsByNode := map[string][]corev1.Pod{}
+	for i := range list.Items {
+		pod := corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], &pod); err != nil {
+			return nil, err
+		}
+
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+	}
+
+	return podsByNode, nil
+}
+
+// createNodeUtilization prints the cpu and memory requested by the pods on a node, and
+// the number of pods on the node, as a percentage of what is allocatable. If the pods
+// on nodes are unknown, or the node doesn't publish an allocatable amount, the values
+// are unavailable.
+func createNodeUtilization(node corev1.Node, podsByNode map[string][]corev1.Pod) *component.MultiBar {
+	multiBar := component.NewMultiBar("Utilization", nodeUtilizationThreshold)
+
+	if podsByNode == nil {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourcePods} {
+			multiBar.Add(component.NewUnavailableMultiBarEntry(string(name)))
+		}
+		return multiBar
+	}
+
+	pods := podsByNode[node.Name]
+
+	requested := corev1.ResourceList{}
+	for _, pod := range pods {
+		requests, _ := podTotalResources(pod.Spec)
+		for name, q := range requests {
+			total := requested[name]
+			total.Add(q)
+			requested[name] = total
+		}
+	}
+	requested[corev1.ResourcePods] = *resource.NewQuantity(int64(len(pods)), resource.DecimalSI)
+
+	allocatable := node.Status.Allocatable
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourcePods} {
+		max, ok := allocatable[name]
+		if !ok || max.IsZero() {
+			multiBar.Add(component.NewUnavailableMultiBarEntry(string(name)))
+			continue
+		}
+
+		value := requested[name]
+		multiBar.Add(component.NewMultiBarEntry(
+			string(name),
+			float64(value.MilliValue()),
+			float64(max.MilliValue()),
+			fmt.Sprintf("%s/%s", value.String(), max.String())))
+	}
+
+	return multiBar
+}
+
 // NodeHandler is a printFunc that prints nodes
 func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (component.Component, error) {
 	o := NewObject(node)
@@ -82,6 +174,9 @@ func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (compo
 	if err := nh.Images(options); err != nil {
 		return nil, errors.Wrap(err, "print node images")
 	}
+	if err := nh.EvictionCandidates(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print node eviction candidates")
+	}
 	return o.ToComponent(ctx, options)
 }
 
@@ -356,6 +451,7 @@ type nodeObject interface {
 	Resources(options Options) error
 	Conditions(options Options) error
 	Images(options Options) error
+	EvictionCandidates(ctx context.Context, options Options) error
 }
 
 type nodeHandler struct {
@@ -475,3 +571,17 @@ func (n *nodeHandler) Images(options Options) error {
 func defaultNodeImages(node *corev1.Node, options Options) (*component.Table, error) {
 	return createNodeImagesView(node)
 }
+
+func (n *nodeHandler) EvictionCandidates(ctx context.Context, options Options) error {
+	if n.node == nil {
+		return errors.New("can't display eviction candidates for nil node")
+	}
+
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createNodeEvictionView(ctx, n.node, options)
+		},
+	})
+	return nil
+}
@@ -0,0 +1,289 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	nodeEvictionCols = component.NewTableCols("Rank", "Pod", "QoS", "Priority", "Request", "Usage", "Eviction")
+)
+
+// evictionCandidate is a pod on a node under pressure, and what the kubelet considers when
+// ranking it for eviction.
+type evictionCandidate struct {
+	pod      corev1.Pod
+	qos      corev1.PodQOSClass
+	priority int32
+	request  resource.Quantity
+	usage    *resource.Quantity
+}
+
+// exceedsRequest returns true if the pod is known to use more of the resource than it
+// requests.
+func (e evictionCandidate) exceedsRequest() bool {
+	return e.usage != nil && e.usage.Cmp(e.request) > 0
+}
+
+// tier orders candidates by how soon they are evicted: best effort pods first, then
+// burstable pods using more than they request, then other burstable pods. Guaranteed pods
+// are evicted last.
+func (e evictionCandidate) tier() int {
+	switch {
+	case e.qos == corev1.PodQOSBestEffort:
+		return 0
+	case e.qos == corev1.PodQOSGuaranteed:
+		return 3
+	case e.exceedsRequest():
+		return 1
+	default:
+		return 2
+	}
+}
+
+// overRequest is how much more of the resource than it requests the pod uses.
+func (e evictionCandidate) overRequest() int64 {
+	if e.usage == nil {
+		return 0
+	}
+	return e.usage.MilliValue() - e.request.MilliValue()
+}
+
+// createNodeEvictionView ranks the pods on a node under memory or disk pressure by the
+// order the kubelet evicts them in. Pods are ranked by QoS class and whether they use more
+// than they request, then by priority, then by how far over their request they are. Memory
+// usage is read from cached pod metrics when they are available. If the node is not under
+// pressure, no view is returned.
+func createNodeEvictionView(ctx context.Context, node *corev1.Node, options Options) (component.Component, error) {
+	if node == nil {
+		return nil, errors.New("node is nil")
+	}
+
+	resourceName, pressure, ok := nodeEvictionResource(node)
+	if !ok {
+		return nil, nil
+	}
+
+	podsByNode, err := listPodsByNode(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []evictionCandidate
+	for _, pod := range podsByNode[node.Name] {
+		candidate := evictionCandidate{
+			pod:     pod,
+			qos:     podQOSClass(pod),
+			request: podResourceRequest(pod.Spec, resourceName),
+		}
+		if pod.Spec.Priority != nil {
+			candidate.priority = *pod.Spec.Priority
+		}
+		if resourceName == corev1.ResourceMemory {
+			candidate.usage = podMemoryUsage(ctx, pod, options)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch {
+		case a.tier() != b.tier():
+			return a.tier() < b.tier()
+		case a.priority != b.priority:
+			return a.priority < b.priority
+		case a.overRequest() != b.overRequest():
+			return a.overRequest() > b.overRequest()
+		default:
+			return a.pod.Namespace+"/"+a.pod.Name < b.pod.Namespace+"/"+b.pod.Name
+		}
+	})
+
+	table := component.NewTable(fmt.Sprintf("Eviction Candidates (%s)", pressure),
+		"There are no pods on this node!", nodeEvictionCols)
+
+	for i, candidate := range candidates {
+		pod := candidate.pod
+
+		nameLink, err := options.Link.ForObject(&pod, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		usage := "<unknown>"
+		if candidate.usage != nil {
+			usage = candidate.usage.String()
+		}
+
+		table.Add(component.TableRow{
+			"Rank":     component.NewTextf("%d", i+1),
+			"Pod":      nameLink,
+			"QoS":      component.NewText(string(candidate.qos)),
+			"Priority": component.NewTextf("%d", candidate.priority),
+			"Request":  component.NewText(candidate.request.String()),
+			"Usage":    component.NewText(usage),
+			"Eviction": describeEvictionCandidate(candidate),
+		})
+	}
+
+	return table, nil
+}
+
+func describeEvictionCandidate(candidate evictionCandidate) *component.Text {
+	var text *component.Text
+
+	switch candidate.tier() {
+	case 0:
+		text = component.NewText("First: no requests")
+		text.SetStatus(component.TextStatusError)
+	case 1:
+		text = component.NewText("Early: usage exceeds request")
+		text.SetStatus(component.TextStatusWarning)
+	case 3:
+		text = component.NewText("Last to evict")
+		text.SetStatus(component.TextStatusOK)
+	default:
+		text = component.NewText("After pods exceeding requests")
+	}
+
+	return text
+}
+
+// nodeEvictionResource returns the resource a node under pressure evicts pods to reclaim,
+// and the pressure condition it reports. Memory pressure takes precedence over disk
+// pressure.
+func nodeEvictionResource(node *corev1.Node) (corev1.ResourceName, string, bool) {
+	var pressures []corev1.NodeConditionType
+	for _, condition := range node.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case corev1.NodeMemoryPressure, corev1.NodeDiskPressure:
+			pressures = append(pressures, condition.Type)
+		}
+	}
+
+	if len(pressures) == 0 {
+		return "", "", false
+	}
+
+	var names []string
+	resourceName := corev1.ResourceEphemeralStorage
+	for _, pressure := range pressures {
+		names = append(names, string(pressure))
+		if pressure == corev1.NodeMemoryPressure {
+			resourceName = corev1.ResourceMemory
+		}
+	}
+	sort.Strings(names)
+
+	return resourceName, strings.Join(names, ", "), true
+}
+
+// podQOSClass returns a pod's QoS class. Pods which haven't published a class have it
+// derived from their containers' requests and limits.
+func podQOSClass(pod corev1.Pod) corev1.PodQOSClass {
+	if pod.Status.QOSClass != "" {
+		return pod.Status.QOSClass
+	}
+
+	containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+
+	guaranteed := true
+	bestEffort := true
+
+	for _, c := range containers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			request, hasRequest := c.Resources.Requests[name]
+			limit, hasLimit := c.Resources.Limits[name]
+
+			if (hasRequest && !request.IsZero()) || (hasLimit && !limit.IsZero()) {
+				bestEffort = false
+			}
+
+			if !hasLimit || (hasRequest && request.Cmp(limit) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+
+	switch {
+	case bestEffort:
+		return corev1.PodQOSBestEffort
+	case guaranteed:
+		return corev1.PodQOSGuaranteed
+	default:
+		return corev1.PodQOSBurstable
+	}
+}
+
+// podResourceRequest returns the total amount of a resource requested by a pod's containers.
+func podResourceRequest(podSpec corev1.PodSpec, name corev1.ResourceName) resource.Quantity {
+	total := resource.Quantity{Format: resource.BinarySI}
+	for _, c := range podSpec.Containers {
+		if q, ok := c.Resources.Requests[name]; ok {
+			total.Add(q)
+		}
+	}
+	return total
+}
+
+// podMemoryUsage returns the memory used by a pod's containers from its cached metrics. If
+// the pod has no metrics, its usage is unknown.
+func podMemoryUsage(ctx context.Context, pod corev1.Pod, options Options) *resource.Quantity {
+	key := store.Key{
+		Namespace:  pod.Namespace,
+		APIVersion: "metrics.k8s.io/v1beta1",
+		Kind:       "PodMetrics",
+		Name:       pod.Name,
+	}
+
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		log.From(ctx).Errorf("get pod metrics for %s: %v", pod.Name, err)
+		return nil
+	}
+
+	if object == nil {
+		return nil
+	}
+
+	containers, found, err := unstructured.NestedSlice(object.Object, "containers")
+	if err != nil || !found {
+		return nil
+	}
+
+	total := resource.Quantity{Format: resource.BinarySI}
+	for _, c := range containers {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		usage, _, _ := unstructured.NestedString(m, "usage", "memory")
+		q, err := resource.ParseQuantity(usage)
+		if err != nil {
+			continue
+		}
+		total.Add(q)
+	}
+
+	return &total
+}
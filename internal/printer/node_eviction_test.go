@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createNodeEvictionView(t *testing.T) {
+	memoryPressure := []corev1.NodeCondition{
+		{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue},
+		{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse},
+	}
+
+	resources := func(request, limit string) corev1.ResourceRequirements {
+		requirements := corev1.ResourceRequirements{}
+		if request != "" {
+			requirements.Requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(request)}
+		}
+		if limit != "" {
+			requirements.Limits = corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse(limit),
+				corev1.ResourceCPU:    resource.MustParse("1"),
+			}
+			requirements.Requests[corev1.ResourceCPU] = resource.MustParse("1")
+		}
+		return requirements
+	}
+
+	newPod := func(name, nodeName string, priority int32, requirements corev1.ResourceRequirements) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Spec.NodeName = nodeName
+		pod.Spec.Priority = &priority
+		pod.Spec.Containers = []corev1.Container{{Name: "app", Resources: requirements}}
+		return pod
+	}
+
+	newPodMetrics := func(name, memory string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "metrics.k8s.io/v1beta1",
+			"kind":       "PodMetrics",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "namespace"},
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "usage": map[string]interface{}{"memory": memory}},
+			},
+		}}
+	}
+
+	text := func(s string, status component.TextStatus) *component.Text {
+		t := component.NewText(s)
+		t.SetStatus(status)
+		return t
+	}
+
+	row := func(rank, name, qos, priority, request, usage string, eviction *component.Text) component.TableRow {
+		return component.TableRow{
+			"Rank":     component.NewText(rank),
+			"Pod":      component.NewLink("", name, "/"+name),
+			"QoS":      component.NewText(qos),
+			"Priority": component.NewText(priority),
+			"Request":  component.NewText(request),
+			"Usage":    component.NewText(usage),
+			"Eviction": eviction,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		conditions []corev1.NodeCondition
+		pods       []runtime.Object
+		metrics    map[string]*unstructured.Unstructured
+		expected   func() component.Component
+	}{
+		{
+			name: "node not under pressure",
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+			},
+		},
+		{
+			name:       "pods ranked by eviction order",
+			conditions: memoryPressure,
+			pods: []runtime.Object{
+				newPod("guaranteed", "node", 0, resources("100Mi", "100Mi")),
+				newPod("within-request", "node", 0, resources("100Mi", "")),
+				newPod("high-priority", "node", 1000, resources("100Mi", "")),
+				newPod("over-request", "node", 0, resources("100Mi", "")),
+				newPod("best-effort", "node", 0, resources("", "")),
+				newPod("other-node", "other", 0, resources("", "")),
+			},
+			metrics: map[string]*unstructured.Unstructured{
+				"guaranteed":     newPodMetrics("guaranteed", "90Mi"),
+				"within-request": newPodMetrics("within-request", "50Mi"),
+				"over-request":   newPodMetrics("over-request", "300Mi"),
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Eviction Candidates (MemoryPressure)",
+					"There are no pods on this node!", nodeEvictionCols)
+				table.Add(
+					row("1", "best-effort", "BestEffort", "0", "0", "<unknown>",
+						text("First: no requests", component.TextStatusError)),
+					row("2", "over-request", "Burstable", "0", "100Mi", "300Mi",
+						text("Early: usage exceeds request", component.TextStatusWarning)),
+					row("3", "within-request", "Burstable", "0", "100Mi", "50Mi",
+						component.NewText("After pods exceeding requests")),
+					row("4", "high-priority", "Burstable", "1000", "100Mi", "<unknown>",
+						component.NewText("After pods exceeding requests")),
+					row("5", "guaranteed", "Guaranteed", "0", "100Mi", "90Mi",
+						text("Last to evict", component.TextStatusOK)),
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			node := testutil.CreateNode("node")
+			node.Status.Conditions = test.conditions
+
+			if test.expected != nil {
+				tpo.objectStore.EXPECT().
+					List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"}).
+					Return(testutil.ToUnstructuredList(t, test.pods...), false, nil)
+
+				for _, object := range test.pods {
+					pod := object.(*corev1.Pod)
+					if pod.Spec.NodeName != node.Name {
+						continue
+					}
+
+					key := store.Key{Namespace: "namespace", APIVersion: "metrics.k8s.io/v1beta1", Kind: "PodMetrics", Name: pod.Name}
+					tpo.objectStore.EXPECT().Get(ctx, key).Return(test.metrics[pod.Name], nil)
+
+					tpo.link.EXPECT().ForObject(gomock.Any(), pod.Name).
+						Return(component.NewLink("", pod.Name, "/"+pod.Name), nil)
+				}
+			}
+
+			got, err := createNodeEvictionView(ctx, node, tpo.ToOptions())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
@@ -3,13 +3,18 @@ package printer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -24,8 +29,40 @@ func TestNodeListHandler(t *testing.T) {
 	node.Status.NodeInfo.KubeletVersion = "1.15.1"
 	node.CreationTimestamp = *testutil.CreateTimestamp()
 
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+		corev1.ResourcePods:   resource.MustParse("110"),
+	}
+
 	tpo.PathForObject(node, node.Name, "/node")
 
+	running := testutil.CreatePod("running")
+	running.Spec.NodeName = "node-1"
+	running.Spec.Containers = []corev1.Container{
+		{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1900m"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+
+	completed := running.DeepCopy()
+	completed.Name = "completed"
+	completed.Status.Phase = corev1.PodSucceeded
+
+	elsewhere := running.DeepCopy()
+	elsewhere.Name = "elsewhere"
+	elsewhere.Spec.NodeName = "node-2"
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, running, completed, elsewhere), false, nil)
+
 	list := &corev1.NodeList{
 		Items: []corev1.Node{
 			*node,
@@ -43,7 +80,12 @@ func TestNodeListHandler(t *testing.T) {
 			"Labels":  component.NewLabels(make(map[string]string)),
 			"Version": component.NewText("1.15.1"),
 			"Status":  component.NewText("Unknown"),
-			"Roles":   component.NewText("<none>"),
+			"Utilization": component.NewMultiBar("Utilization", nodeUtilizationThreshold,
+				component.NewMultiBarEntry("cpu", 1900, 2000, "1900m/2"),
+				component.NewMultiBarEntry("memory", 1073741824000, 4294967296000, "1Gi/4Gi"),
+				component.NewMultiBarEntry("pods", 1000, 110000, "1/110"),
+			),
+			"Roles": component.NewText("<none>"),
 		},
 	})
 
@@ -288,3 +330,72 @@ func Test_createNodeImagesView(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_createNodeUtilization(t *testing.T) {
+	node := testutil.CreateNode("node")
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourcePods: resource.MustParse("110"),
+	}
+
+	tests := []struct {
+		name       string
+		podsByNode map[string][]corev1.Pod
+		expected   *component.MultiBar
+	}{
+		{
+			name: "pods unknown",
+			expected: component.NewMultiBar("Utilization", nodeUtilizationThreshold,
+				component.NewUnavailableMultiBarEntry("cpu"),
+				component.NewUnavailableMultiBarEntry("memory"),
+				component.NewUnavailableMultiBarEntry("pods"),
+			),
+		},
+		{
+			name:       "allocatable unknown",
+			podsByNode: map[string][]corev1.Pod{},
+			expected: component.NewMultiBar("Utilization", nodeUtilizationThreshold,
+				component.NewUnavailableMultiBarEntry("cpu"),
+				component.NewUnavailableMultiBarEntry("memory"),
+				component.NewMultiBarEntry("pods", 0, 110000, "0/110"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := createNodeUtilization(*node, test.podsByNode)
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
+func TestNodeListHandler_ageFilter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	now := testutil.Time()
+
+	recent := testutil.CreateNode("recent")
+	recent.CreationTimestamp = metav1.NewTime(now.Add(-10 * time.Minute))
+	old := testutil.CreateNode("old")
+	old.CreationTimestamp = metav1.NewTime(now.Add(-30 * 24 * time.Hour))
+
+	tpo.PathForObject(recent, recent.Name, "/recent")
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+
+	printOptions := tpo.ToOptions()
+	printOptions.MaxAge = 7 * 24 * time.Hour
+	printOptions.AgeBuckets = true
+	printOptions.Clock = clock.NewFakeClock(now)
+
+	list := &corev1.NodeList{Items: []corev1.Node{*recent, *old}}
+
+	got, err := NodeListHandler(context.Background(), list, printOptions)
+	require.NoError(t, err)
+
+	assertAgeBuckets(t, got, []string{ageBucketHour})
+}
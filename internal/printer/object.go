@@ -94,6 +94,8 @@ type Object struct {
 	summary         *component.Summary
 	isEventsEnabled bool
 
+	isProblemsEnabled bool
+
 	itemsLists [][]ItemDescriptor
 
 	isPodTemplateEnabled bool
@@ -109,6 +111,7 @@ type Object struct {
 	PodTemplateGen func(context.Context, runtime.Object, corev1.PodTemplateSpec, *flexlayout.FlexLayout, Options) error
 	JobTemplateGen func(context.Context, runtime.Object, batchv1beta1.JobTemplateSpec, *flexlayout.FlexLayout, Options) error
 	EventsGen      func(ctx context.Context, object runtime.Object, fl *flexlayout.FlexLayout, options Options) error
+	ProblemsGen    func(ctx context.Context, object runtime.Object, options Options) (*component.Summary, error)
 }
 
 // NewObject creates an instance of Object.
@@ -120,6 +123,7 @@ func NewObject(object runtime.Object, options ...ObjectOpts) *Object {
 		PodTemplateGen: defaultPodTemplateGen,
 		JobTemplateGen: defaultJobTemplateGen,
 		EventsGen:      defaultEventsGen,
+		ProblemsGen:    createProblemsView,
 	}
 
 	for _, option := range options {
@@ -158,6 +162,11 @@ func (o *Object) EnableEvents() {
 	o.isEventsEnabled = true
 }
 
+// EnableProblems enables the problems panel at the top of the object's view.
+func (o *Object) EnableProblems() {
+	o.isProblemsEnabled = true
+}
+
 // RegisterItems registers one or more items to be printed in a section.
 // Each call to RegisterItems will create a new section.
 func (o *Object) RegisterItems(items ...ItemDescriptor) {
@@ -194,6 +203,31 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 		return nil, fmt.Errorf("object is nil")
 	}
 
+	pinned, err := createPinnedFieldsView(ctx, o.object, options)
+	if err != nil {
+		return nil, fmt.Errorf("print pinned fields: %w", err)
+	}
+
+	if len(pinned) > 0 {
+		pinnedSection := o.flexLayout.AddSection()
+		for _, stat := range pinned {
+			if err := pinnedSection.Add(stat, component.WidthQuarter); err != nil {
+				return nil, fmt.Errorf("add pinned field to layout: %w", err)
+			}
+		}
+	}
+
+	if o.isProblemsEnabled {
+		problems, err := o.ProblemsGen(ctx, o.object, options)
+		if err != nil {
+			return nil, fmt.Errorf("detect problems: %w", err)
+		}
+
+		if err := o.flexLayout.AddSection().Add(problems, component.WidthFull); err != nil {
+			return nil, fmt.Errorf("add problems to layout: %w", err)
+		}
+	}
+
 	summarySection := o.flexLayout.AddSection()
 
 	pluginPrinter := options.DashConfig.PluginManager()
@@ -11,6 +11,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/objectstatus"
 	"github.com/vmware-tanzu/octant/internal/octant"
@@ -27,6 +28,8 @@ type ObjectTable struct {
 	filters     map[string]component.TableFilter
 	sortOrder   *tableSetOrder
 	store       store.Store
+	ageFilter   ageFilter
+	thresholds  map[schema.GroupVersionKind]ThresholdConfig
 }
 
 // NewObjectTable creates an instance of ObjectTable.
@@ -42,6 +45,19 @@ func NewObjectTable(title, placeholder string, cols []component.TableCol, object
 	return &ol
 }
 
+// SetAgeFilter excludes objects outside of the age window in options from the table, and
+// groups objects into age buckets if options.AgeBuckets is set.
+func (ol *ObjectTable) SetAgeFilter(options Options) {
+	ol.ageFilter = newAgeFilter(options)
+}
+
+// SetStatusThresholds sets the status of a workload row from the replica status threshold
+// configured in options for the workload's kind. Kinds without a configured threshold keep
+// their object status.
+func (ol *ObjectTable) SetStatusThresholds(options Options) {
+	ol.thresholds = options.StatusThresholds
+}
+
 // AddFilters adds filters to a set of table columns.
 func (ol *ObjectTable) AddFilters(filters map[string]component.TableFilter) {
 	for k, v := range filters {
@@ -65,6 +81,10 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 		return fmt.Errorf("get accessor for object: %w", err)
 	}
 
+	if !ol.ageFilter.filterRow(accessor.GetCreationTimestamp().Time, row) {
+		return nil
+	}
+
 	if accessor.GetDeletionTimestamp() != nil {
 		row["_isDeleted"] = component.NewText("deleted")
 	}
@@ -74,11 +94,18 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 		return fmt.Errorf("get status for object: %w", err)
 	}
 
+	textStatus := convertNodeStatusToTextStatus(status.Status())
+	if accessor.GetDeletionTimestamp() == nil {
+		if thresholdStatus, ok := ol.thresholdStatus(object); ok {
+			textStatus = thresholdStatus
+		}
+	}
+
 	if len(ol.cols) > 0 {
 		firstRow := row[ol.cols[0].Name]
 		if cs, ok := firstRow.(componentStatus); ok {
 			detailComponent := component.NewList(nil, status.Details)
-			cs.SetStatus(convertNodeStatusToTextStatus(status.Status()), detailComponent)
+			cs.SetStatus(textStatus, detailComponent)
 		}
 	}
 
@@ -89,6 +116,31 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 	return nil
 }
 
+// thresholdStatus returns the status of a workload's ready replicas using the status
+// threshold configured for its kind.
+func (ol *ObjectTable) thresholdStatus(object runtime.Object) (component.TextStatus, bool) {
+	if len(ol.thresholds) == 0 {
+		return 0, false
+	}
+
+	groupVersionKind, err := objectGroupVersionKind(object)
+	if err != nil {
+		return 0, false
+	}
+
+	threshold, ok := ol.thresholds[groupVersionKind]
+	if !ok || threshold.Validate() != nil {
+		return 0, false
+	}
+
+	ready, desired, ok := readyReplicas(object)
+	if !ok {
+		return 0, false
+	}
+
+	return threshold.Status(ready, desired), true
+}
+
 func convertNodeStatusToTextStatus(nodeStatus component.NodeStatus) component.TextStatus {
 	switch nodeStatus {
 	case component.NodeStatusOK:
@@ -140,12 +192,24 @@ func (ol *ObjectTable) SetSortOrder(name string, reverse bool) {
 
 // ToComponent converts the ObjectTable instance to a component.
 func (ol *ObjectTable) ToComponent() (component.Component, error) {
-	table := component.NewTableWithRows(ol.title, ol.placeholder, ol.cols, ol.rows)
+	cols := ol.cols
+	if ol.ageFilter.buckets {
+		cols = append(append([]component.TableCol{}, cols...), component.NewTableCols(ageBucketColumn)...)
+	}
+
+	table := component.NewTableWithRows(ol.title, ol.placeholder, cols, ol.rows)
 
 	for name, filter := range ol.filters {
 		table.AddFilter(name, filter)
 	}
 
+	if ol.ageFilter.buckets {
+		table.AddFilter(ageBucketColumn, component.TableFilter{
+			Values:   ageBuckets,
+			Selected: []string{},
+		})
+	}
+
 	if so := ol.sortOrder; so != nil {
 		table.Sort(so.name, so.reverse)
 	}
@@ -8,12 +8,16 @@ package printer
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store/fake"
@@ -150,3 +154,108 @@ func TestObjectTable(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectTable_SetAgeFilter(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cols := component.NewTableCols("Name")
+
+	ages := map[string]time.Duration{
+		"minutes": 10 * time.Minute,
+		"hours":   5 * time.Hour,
+		"days":    3 * 24 * time.Hour,
+		"weeks":   30 * 24 * time.Hour,
+	}
+
+	tests := []struct {
+		name     string
+		options  Options
+		expected map[string]string
+	}{
+		{
+			name:     "no age filter",
+			expected: map[string]string{"minutes": "", "hours": "", "days": "", "weeks": ""},
+		},
+		{
+			name:     "min age",
+			options:  Options{MinAge: time.Hour},
+			expected: map[string]string{"hours": "", "days": "", "weeks": ""},
+		},
+		{
+			name:     "max age",
+			options:  Options{MaxAge: 24 * time.Hour},
+			expected: map[string]string{"minutes": "", "hours": ""},
+		},
+		{
+			name:     "age window",
+			options:  Options{MinAge: time.Hour, MaxAge: 7 * 24 * time.Hour},
+			expected: map[string]string{"hours": "", "days": ""},
+		},
+		{
+			name:    "age buckets",
+			options: Options{AgeBuckets: true},
+			expected: map[string]string{
+				"minutes": ageBucketHour,
+				"hours":   ageBucketDay,
+				"days":    ageBucketWeek,
+				"weeks":   ageBucketOlder,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			objectStore := fake.NewMockStore(ctrl)
+
+			ot := NewObjectTable("table", "placeholder", cols, objectStore)
+
+			options := test.options
+			options.Clock = clock.NewFakeClock(now)
+			ot.SetAgeFilter(options)
+
+			var names []string
+			for name := range ages {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				pod := testutil.CreatePod(name)
+				pod.CreationTimestamp = metav1.NewTime(now.Add(-ages[name]))
+				require.NoError(t, ot.AddRowForObject(ctx, pod, component.TableRow{
+					"Name": component.NewText(name),
+				}))
+			}
+
+			got, err := ot.ToComponent()
+			require.NoError(t, err)
+
+			table, ok := got.(*component.Table)
+			require.True(t, ok)
+
+			actual := make(map[string]string)
+			for _, row := range table.Rows() {
+				name := row["Name"].(*component.Text).Config.Text
+
+				var bucket string
+				if text, ok := row[ageBucketColumn].(*component.Text); ok {
+					bucket = text.Config.Text
+				}
+				actual[name] = bucket
+			}
+			require.Equal(t, test.expected, actual)
+
+			if test.options.AgeBuckets {
+				require.Equal(t, component.NewTableCols("Name", ageBucketColumn), table.Columns())
+				require.Equal(t, ageBuckets, table.Config.Filters[ageBucketColumn].Values)
+			} else {
+				require.Equal(t, cols, table.Columns())
+			}
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
 
@@ -140,6 +142,47 @@ func Test_Object_ToComponent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "enable problems",
+			object: deployment,
+			initFunc: func(o *Object, options *initOptions) {
+				o.EnableProblems()
+				o.ProblemsGen = func(_ context.Context, _ runtime.Object, _ Options) (*component.Summary, error) {
+					return component.NewSummary("Problems"), nil
+				}
+				stubPlugins(options.PluginPrinter)
+			},
+			sections: []component.FlexLayoutSection{
+				{
+					{
+						Width: component.WidthFull,
+						View:  component.NewSummary("Problems"),
+					},
+				},
+				defaultConfigSection,
+			},
+		},
+		{
+			name:   "pinned fields",
+			object: deployment,
+			initFunc: func(o *Object, options *initOptions) {
+				options.Options.PinnedFields = map[schema.GroupVersionKind][]PinnedField{
+					appsv1.SchemeGroupVersion.WithKind("Deployment"): {
+						{Title: "Name", JSONPath: ".metadata.name"},
+					},
+				}
+				stubPlugins(options.PluginPrinter)
+			},
+			sections: []component.FlexLayoutSection{
+				{
+					{
+						Width: component.WidthQuarter,
+						View:  component.NewSingleStat("Name", "deployment", ""),
+					},
+				},
+				defaultConfigSection,
+			},
+		},
 		{
 			name:   "register items",
 			object: deployment,
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// ownerTreeMaxDepth is the number of owners and the number of descendant levels
+	// which are traversed when building an owner tree.
+	ownerTreeMaxDepth = 5
+)
+
+// ownedKinds are the kinds of objects controlled by each kind of controller.
+var ownedKinds = map[schema.GroupVersionKind]store.Key{
+	{Group: "apps", Version: "v1", Kind: "Deployment"}:        {APIVersion: "apps/v1", Kind: "ReplicaSet"},
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"}:        {APIVersion: "v1", Kind: "Pod"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"}:       {APIVersion: "v1", Kind: "Pod"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"}:         {APIVersion: "v1", Kind: "Pod"},
+	{Group: "batch", Version: "v1", Kind: "Job"}:              {APIVersion: "v1", Kind: "Pod"},
+	{Group: "batch", Version: "v1beta1", Kind: "CronJob"}:     {APIVersion: "batch/v1", Kind: "Job"},
+	{Group: "", Version: "v1", Kind: "ReplicationController"}: {APIVersion: "v1", Kind: "Pod"},
+}
+
+// createOwnerTreeView prints an object's chain of controllers and the objects it controls
+// as a tree. Owners and descendants are traversed up to ownerTreeMaxDepth levels. If the
+// object has no owners or descendants, no view is returned.
+func createOwnerTreeView(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	node, err := ownerTreeNode(object, options)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := ownerTreeDescendants(ctx, object, objectStore, options, ownerTreeMaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	node.Children = children
+
+	owners, err := ownerTreeAncestors(ctx, object, objectStore, ownerTreeMaxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(owners) == 0 && len(node.Children) == 0 {
+		return nil, nil
+	}
+
+	for _, owner := range owners {
+		ownerNode, err := ownerTreeNode(owner, options)
+		if err != nil {
+			return nil, err
+		}
+		ownerNode.Children = []component.TreeNode{node}
+		node = ownerNode
+	}
+
+	tree := component.NewTree("Owners", node)
+	tree.Truncate(2*ownerTreeMaxDepth + 1)
+
+	return tree, nil
+}
+
+// ownerTreeAncestors returns an object's controllers, starting with its direct controller.
+// Controllers which are not in the cache end the chain.
+func ownerTreeAncestors(ctx context.Context, object runtime.Object, objectStore store.Store, maxDepth int) ([]runtime.Object, error) {
+	var owners []runtime.Object
+
+	current := object
+	for len(owners) < maxDepth {
+		accessor, err := meta.Accessor(current)
+		if err != nil {
+			return nil, err
+		}
+
+		controllerRef := metav1.GetControllerOf(accessor)
+		if controllerRef == nil {
+			break
+		}
+
+		key := store.Key{
+			Namespace:  accessor.GetNamespace(),
+			APIVersion: controllerRef.APIVersion,
+			Kind:       controllerRef.Kind,
+			Name:       controllerRef.Name,
+		}
+
+		owner, err := objectStore.Get(ctx, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get owner %s", key)
+		}
+
+		if owner == nil {
+			break
+		}
+
+		owners = append(owners, owner)
+		current = owner
+	}
+
+	return owners, nil
+}
+
+// ownerTreeDescendants returns nodes for the objects controlled by an object.
+func ownerTreeDescendants(ctx context.Context, object runtime.Object, objectStore store.Store, options Options, depth int) ([]component.TreeNode, error) {
+	if depth <= 0 {
+		return nil, nil
+	}
+
+	childKey, ok := ownedKinds[object.GetObjectKind().GroupVersionKind()]
+	if !ok {
+		return nil, nil
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, err
+	}
+
+	childKey.Namespace = accessor.GetNamespace()
+
+	list, _, err := objectStore.List(ctx, childKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s", childKey)
+	}
+
+	var nodes []component.TreeNode
+	for i := range list.Items {
+		child := &list.Items[i]
+
+		controllerRef := metav1.GetControllerOf(child)
+		if controllerRef == nil || controllerRef.UID != accessor.GetUID() {
+			continue
+		}
+
+		node, err := ownerTreeNode(child, options)
+		if err != nil {
+			return nil, err
+		}
+
+		node.Children, err = ownerTreeDescendants(ctx, child, objectStore, options, depth-1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// ownerTreeNode creates a tree node for an object. The node links to the object's
+// path if one can be resolved.
+func ownerTreeNode(object runtime.Object, options Options) (component.TreeNode, error) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return component.TreeNode{}, err
+	}
+
+	apiVersion, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+	label := fmt.Sprintf("%s %s", kind, accessor.GetName())
+
+	l, err := options.Link.ForGVK(accessor.GetNamespace(), apiVersion, kind, accessor.GetName(), label)
+	if err != nil {
+		return component.NewTreeNode(label, ""), nil
+	}
+
+	return component.NewTreeNode(label, l.Ref()), nil
+}
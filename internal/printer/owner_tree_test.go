@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createOwnerTreeView(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	replicaSet := testutil.CreateAppReplicaSet("replica-set")
+	replicaSet.OwnerReferences = testutil.ToOwnerReferences(t, deployment)
+
+	pod := testutil.CreatePod("pod")
+	pod.OwnerReferences = testutil.ToOwnerReferences(t, replicaSet)
+
+	otherPod := testutil.CreatePod("other-pod")
+
+	deploymentKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+	replicaSetKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "replica-set"}
+	replicaSetListKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet"}
+	podListKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		setup    func(t *testing.T, tpo *testPrinterOptions)
+		expected func() component.Component
+	}{
+		{
+			name:   "object without owners or descendants",
+			object: otherPod,
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:   "pod with owners",
+			object: pod,
+			setup: func(t *testing.T, tpo *testPrinterOptions) {
+				tpo.objectStore.EXPECT().Get(gomock.Any(), replicaSetKey).
+					Return(testutil.ToUnstructured(t, replicaSet), nil)
+				tpo.objectStore.EXPECT().Get(gomock.Any(), deploymentKey).
+					Return(testutil.ToUnstructured(t, deployment), nil)
+				tpo.PathForGVK("namespace", "v1", "Pod", "pod", "Pod pod", "/pod")
+			},
+			expected: func() component.Component {
+				return component.NewTree("Owners",
+					component.NewTreeNode("Deployment deployment", "/deployment",
+						component.NewTreeNode("ReplicaSet replica-set", "/replica-set",
+							component.NewTreeNode("Pod pod", "/pod"))))
+			},
+		},
+		{
+			name:   "replica set with owners and descendants",
+			object: replicaSet,
+			setup: func(t *testing.T, tpo *testPrinterOptions) {
+				tpo.objectStore.EXPECT().Get(gomock.Any(), deploymentKey).
+					Return(testutil.ToUnstructured(t, deployment), nil)
+				tpo.objectStore.EXPECT().List(gomock.Any(), podListKey).
+					Return(testutil.ToUnstructuredList(t, pod, otherPod), false, nil)
+				tpo.PathForGVK("namespace", "v1", "Pod", "pod", "Pod pod", "/pod")
+			},
+			expected: func() component.Component {
+				return component.NewTree("Owners",
+					component.NewTreeNode("Deployment deployment", "/deployment",
+						component.NewTreeNode("ReplicaSet replica-set", "/replica-set",
+							component.NewTreeNode("Pod pod", "/pod"))))
+			},
+		},
+		{
+			name:   "deployment with descendants",
+			object: deployment,
+			setup: func(t *testing.T, tpo *testPrinterOptions) {
+				tpo.objectStore.EXPECT().List(gomock.Any(), replicaSetListKey).
+					Return(testutil.ToUnstructuredList(t, replicaSet), false, nil)
+				tpo.objectStore.EXPECT().List(gomock.Any(), podListKey).
+					Return(testutil.ToUnstructuredList(t, pod, otherPod), false, nil)
+				tpo.PathForGVK("namespace", "v1", "Pod", "pod", "Pod pod", "/pod")
+			},
+			expected: func() component.Component {
+				return component.NewTree("Owners",
+					component.NewTreeNode("Deployment deployment", "/deployment",
+						component.NewTreeNode("ReplicaSet replica-set", "/replica-set",
+							component.NewTreeNode("Pod pod", "/pod"))))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			tpo.PathForGVK("namespace", "apps/v1", "Deployment", "deployment", "Deployment deployment", "/deployment")
+			tpo.PathForGVK("namespace", "apps/v1", "ReplicaSet", "replica-set", "ReplicaSet replica-set", "/replica-set")
+			tpo.PathForGVK("namespace", "v1", "Pod", "other-pod", "Pod other-pod", "/other-pod")
+
+			if test.setup != nil {
+				test.setup(t, tpo)
+			}
+
+			got, err := createOwnerTreeView(context.Background(), test.object, tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
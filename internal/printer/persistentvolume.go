@@ -25,6 +25,7 @@ func PersistentVolumeListHandler(ctx context.Context, list *corev1.PersistentVol
 
 	cols := component.NewTableCols("Name", "Capacity", "Access Modes", "Reclaim Policy", "Status", "Claim", "Storage Class", "Reason", "Age")
 	ot := NewObjectTable("Persistent Volumes", "We couldn't find any persistent volumes!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, pv := range list.Items {
 		row := component.TableRow{}
@@ -28,6 +28,7 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 	cols := component.NewTableCols("Name", "Status", "Volume", "Capacity", "Access Modes", "Storage Class", "Age")
 	ot := NewObjectTable("Persistent Volume Claims",
 		"We couldn't find any persistent volume claims!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, persistentVolumeClaim := range list.Items {
 		row := component.TableRow{}
@@ -83,6 +84,7 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 func PersistentVolumeClaimHandler(ctx context.Context, persistentVolumeClaim *corev1.PersistentVolumeClaim, options Options) (component.Component, error) {
 	o := NewObject(persistentVolumeClaim)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	ph, err := newPersistentVolumeClaimHandler(persistentVolumeClaim, o)
 	if err != nil {
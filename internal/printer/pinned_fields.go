@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// pinnedFieldMissing is shown for pinned fields whose path doesn't resolve.
+	pinnedFieldMissing = "—"
+)
+
+// PinnedField is a field shown at the top of an object's view.
+type PinnedField struct {
+	// Title labels the field. If empty, JSONPath is used.
+	Title string
+	// JSONPath selects the field, e.g. .status.phase.
+	JSONPath string
+}
+
+// createPinnedFieldsView prints the fields pinned for an object's kind as a row of stats.
+// Fields whose path doesn't resolve, or is invalid, are shown as a dash. If no fields are
+// pinned for the kind, no stats are returned.
+func createPinnedFieldsView(ctx context.Context, object runtime.Object, options Options) ([]*component.SingleStat, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	if len(options.PinnedFields) == 0 {
+		return nil, nil
+	}
+
+	groupVersionKind, err := objectGroupVersionKind(object)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := options.PinnedFields[groupVersionKind]
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	var m map[string]interface{}
+	if u, ok := object.(runtime.Unstructured); ok {
+		m = u.UnstructuredContent()
+	} else {
+		m, err = runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return nil, errors.Wrap(err, "convert object to unstructured")
+		}
+	}
+
+	var stats []*component.SingleStat
+	for _, field := range fields {
+		title := field.Title
+		if title == "" {
+			title = field.JSONPath
+		}
+
+		value, found, err := executeJSONPath(title, field.JSONPath, m)
+		if err != nil {
+			log.From(ctx).Errorf("print pinned field %q: %v", title, err)
+		}
+		if !found || value == "" {
+			value = pinnedFieldMissing
+		}
+
+		stats = append(stats, component.NewSingleStat(title, value, ""))
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPinnedFieldsView(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.TypeMeta = metav1.TypeMeta{}
+	pod.Status.Phase = corev1.PodRunning
+
+	database := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata":   map[string]interface{}{"name": "db", "namespace": "namespace"},
+		"spec":       map[string]interface{}{"version": "12.4"},
+		"status":     map[string]interface{}{"phase": "Ready"},
+	}}
+
+	pinnedFields := map[schema.GroupVersionKind][]PinnedField{
+		corev1.SchemeGroupVersion.WithKind("Pod"): {
+			{Title: "Phase", JSONPath: ".status.phase"},
+		},
+		schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}: {
+			{Title: "Phase", JSONPath: ".status.phase"},
+			{JSONPath: "{.spec.version}"},
+			{Title: "Replicas", JSONPath: ".spec.replicas"},
+			{Title: "Invalid", JSONPath: ".spec[version"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		object       runtime.Object
+		pinnedFields map[schema.GroupVersionKind][]PinnedField
+		expected     []*component.SingleStat
+	}{
+		{
+			name:   "no pinned fields",
+			object: pod,
+		},
+		{
+			name:         "kind without pinned fields",
+			object:       testutil.CreateDeployment("deployment"),
+			pinnedFields: pinnedFields,
+		},
+		{
+			name:         "typed object without type metadata",
+			object:       pod,
+			pinnedFields: pinnedFields,
+			expected: []*component.SingleStat{
+				component.NewSingleStat("Phase", "Running", ""),
+			},
+		},
+		{
+			name:         "custom resource",
+			object:       database,
+			pinnedFields: pinnedFields,
+			expected: []*component.SingleStat{
+				component.NewSingleStat("Phase", "Ready", ""),
+				component.NewSingleStat("{.spec.version}", "12.4", ""),
+				component.NewSingleStat("Replicas", "—", ""),
+				component.NewSingleStat("Invalid", "—", ""),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := Options{PinnedFields: test.pinnedFields}
+
+			got, err := createPinnedFieldsView(context.Background(), test.object, options)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -8,6 +8,8 @@ package printer
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
@@ -19,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -43,6 +46,7 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 	}
 
 	ot := NewObjectTable("Pods", "We couldn't find any pods!", cols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
 	ot.AddFilters(podTableFilters())
 
 	for i := range list.Items {
@@ -56,17 +60,10 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 		row["Name"] = nameLink
 
 		if !opts.DisableLabels {
-			row["Labels"] = component.NewLabels(pod.Labels)
+			row["Labels"] = createLabelsView(&pod, pod.Labels, opts)
 		}
 
-		readyCounter := 0
-		for _, c := range pod.Status.ContainerStatuses {
-			if c.Ready {
-				readyCounter++
-			}
-		}
-		ready := fmt.Sprintf("%d/%d", readyCounter, len(pod.Spec.Containers))
-		row["Ready"] = component.NewText(ready)
+		row["Ready"] = podReadyContainers(&pod, opts.statusThreshold(gvk.Pod))
 
 		row["Phase"] = component.NewText(string(pod.Status.Phase))
 
@@ -97,6 +94,44 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 	return ot.ToComponent()
 }
 
+// podReadyContainers prints the number of ready containers for a pod. Init containers
+// do not count towards the total. A running pod which has containers that are not ready
+// is flagged by threshold since its phase alone does not show it is degraded.
+func podReadyContainers(pod *corev1.Pod, threshold ThresholdConfig) *component.Text {
+	readyCounter, total := podContainerReadiness(pod)
+
+	text := component.NewTextf("%d/%d", readyCounter, total)
+	if pod.Status.Phase == corev1.PodRunning {
+		if status := threshold.Status(readyCounter, total); status != component.TextStatusOK {
+			text.SetStatus(status)
+		}
+	}
+
+	return text
+}
+
+// podContainerReadiness returns the number of ready containers and the total number of containers in a pod.
+func podContainerReadiness(pod *corev1.Pod) (int, int) {
+	ready := 0
+	for _, c := range pod.Status.ContainerStatuses {
+		if c.Ready {
+			ready++
+		}
+	}
+
+	return ready, len(pod.Spec.Containers)
+}
+
+// isPodDegraded returns true if a pod is running, but not all of its containers are ready.
+func isPodDegraded(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	ready, total := podContainerReadiness(pod)
+	return ready < total
+}
+
 func podNode(pod *corev1.Pod, linkGenerator link.Interface) (component.Component, error) {
 	if nodeName := pod.Spec.NodeName; nodeName != "" {
 		return linkGenerator.ForGVK("", "v1", "Node", pod.Spec.NodeName, pod.Spec.NodeName)
@@ -109,6 +144,7 @@ func podNode(pod *corev1.Pod, linkGenerator link.Interface) (component.Component
 func PodHandler(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
 	o := NewObject(pod)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	ph, err := newPodHandler(pod, o)
 	if err != nil {
@@ -124,15 +160,36 @@ func PodHandler(ctx context.Context, pod *corev1.Pod, options Options) (componen
 	if err := ph.Conditions(options); err != nil {
 		return nil, errors.Wrap(err, "print pod conditions")
 	}
+	if err := ph.SchedulingGates(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod scheduling gates")
+	}
+	if err := ph.NodeConditions(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod node conditions")
+	}
+	if err := ph.TopologySpread(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod topology spread")
+	}
+	if err := ph.ResourceClaims(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod resource claims")
+	}
+	if err := ph.RuntimeClass(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod runtime class")
+	}
+	if err := ph.OwnerTree(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod owner tree")
+	}
 	if err := ph.InitContainers(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print pod init containers")
 	}
 	if err := ph.Containers(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print pod containers")
 	}
-	if err := ph.Additional(options); err != nil {
+	if err := ph.Additional(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print pod additional items")
 	}
+	if err := ph.VolumeDevices(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod volume devices")
+	}
 
 	return o.ToComponent(ctx, options)
 }
@@ -186,6 +243,9 @@ type podStatus struct {
 	Waiting   int
 	Succeeded int
 	Failed    int
+	// Degraded is the number of running pods which have containers that are not ready.
+	// These pods are also counted as Running.
+	Degraded int
 }
 
 func createPodStatus(pods []*corev1.Pod) podStatus {
@@ -195,6 +255,9 @@ func createPodStatus(pods []*corev1.Pod) podStatus {
 		switch pod.Status.Phase {
 		case corev1.PodRunning:
 			ps.Running++
+			if isPodDegraded(pod) {
+				ps.Degraded++
+			}
 		case corev1.PodPending:
 			ps.Waiting++
 		case corev1.PodSucceeded:
@@ -234,6 +297,12 @@ func (p *PodConfiguration) Create(options Options) (*component.Summary, error) {
 	if pod.Spec.PriorityClassName != "" {
 		sections.AddText("PriorityClassName", pod.Spec.PriorityClassName)
 	}
+	if pod.Spec.PreemptionPolicy != nil {
+		sections.AddText("Preemption Policy", string(*pod.Spec.PreemptionPolicy))
+	}
+	if scheduler := podScheduler(pod, options); scheduler != nil {
+		sections.Add("Scheduler", scheduler)
+	}
 
 	contentLink, err := options.Link.ForGVK(pod.Namespace, "v1", "ServiceAccount", pod.Spec.ServiceAccountName, pod.Spec.ServiceAccountName)
 	if err != nil {
@@ -255,6 +324,28 @@ func (p *PodConfiguration) Create(options Options) (*component.Summary, error) {
 	return summary, nil
 }
 
+// podScheduler prints the scheduler for a pod. Pods using another scheduler are flagged as
+// the default scheduler will ignore them. Pods using the default scheduler return nil if
+// options.HideDefaultScheduler is set, as do pods without a scheduler name.
+func podScheduler(pod *corev1.Pod, options Options) *component.Text {
+	schedulerName := pod.Spec.SchedulerName
+	if schedulerName == "" {
+		return nil
+	}
+
+	if schedulerName == corev1.DefaultSchedulerName {
+		if options.HideDefaultScheduler {
+			return nil
+		}
+		return component.NewText(schedulerName)
+	}
+
+	text := component.NewTextf("%s (not scheduled by %s)", schedulerName, corev1.DefaultSchedulerName)
+	text.SetStatus(component.TextStatusWarning)
+
+	return text
+}
+
 func listPods(ctx context.Context, namespace string, selector *metav1.LabelSelector, uid types.UID, o store.Store) ([]*corev1.Pod, error) {
 	key := store.Key{
 		Namespace:  namespace,
@@ -520,6 +611,97 @@ func createPodConditionsView(pod *corev1.Pod) (*component.Table, error) {
 	return table, nil
 }
 
+var podNodeConditionTypes = []corev1.NodeConditionType{
+	corev1.NodeReady,
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+}
+
+// createPodNodeConditionsView prints the conditions of the node the pod is assigned to. Pods
+// which have not been scheduled, or whose node is not in the cache, are skipped.
+func createPodNodeConditionsView(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	nodeName := pod.Spec.NodeName
+	if nodeName == "" {
+		return nil, nil
+	}
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "Node",
+		Name:       nodeName,
+	}
+
+	u, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get node %s", nodeName)
+	}
+	if u == nil {
+		return nil, nil
+	}
+
+	node := &corev1.Node{}
+	if err := kubernetes.FromUnstructured(u, node); err != nil {
+		return nil, err
+	}
+
+	nodeLink, err := options.Link.ForGVK("", "v1", "Node", nodeName, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := component.SummarySections{}
+	sections.Add("Node", nodeLink)
+
+	var problems []string
+
+	for _, conditionType := range podNodeConditionTypes {
+		condition := findNodeCondition(node.Status.Conditions, conditionType)
+		if condition == nil {
+			continue
+		}
+
+		value := string(condition.Status)
+		if condition.Reason != "" {
+			value = fmt.Sprintf("%s (%s)", condition.Status, condition.Reason)
+		}
+		text := component.NewText(value)
+
+		switch {
+		case conditionType == corev1.NodeReady && condition.Status != corev1.ConditionTrue:
+			text.SetStatus(component.TextStatusError)
+			problems = append(problems, "is not ready")
+		case conditionType != corev1.NodeReady && condition.Status == corev1.ConditionTrue:
+			text.SetStatus(component.TextStatusWarning)
+			problems = append(problems, fmt.Sprintf("has %s", conditionType))
+		}
+
+		sections.Add(string(conditionType), text)
+	}
+
+	summary := component.NewSummary("Node Conditions", sections...)
+
+	if len(problems) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Node %s %s", nodeName, strings.Join(problems, ", "))))
+	}
+
+	return summary, nil
+}
+
+func findNodeCondition(conditions []corev1.NodeCondition, conditionType corev1.NodeConditionType) *corev1.NodeCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
 func hasOwnerReference(ownerReferences []metav1.OwnerReference, kind string) bool {
 	for _, ownerReference := range ownerReferences {
 		if ownerReference.Kind == kind {
@@ -530,84 +712,218 @@ func hasOwnerReference(ownerReferences []metav1.OwnerReference, kind string) boo
 }
 
 func printPodResources(podSpec corev1.PodSpec) (*component.Table, error) {
-	table := component.NewTable("Resources", "Pod has no resource needs", podResourceCols)
+	extendedResources := podExtendedResourceNames(podSpec)
+
+	cols := append([]component.TableCol{}, podResourceCols...)
+	for _, name := range extendedResources {
+		cols = append(cols, component.NewTableCols(
+			"Request: "+podResourceLabel(name),
+			"Limit: "+podResourceLabel(name))...)
+	}
+
+	table := component.NewTable("Resources", "Pod has no resource needs", cols)
 
 	// for each container in the spec, there will be requests and limits
-	// for memory and cpu
+	// for memory and cpu, and for any extended resources
 
 	for _, container := range podSpec.Containers {
-		memoryRequest := ""
-		if q := container.Resources.Requests.Memory(); q != nil {
-			memoryRequest = q.String()
+		table.Add(podResourceRow(container.Name, container.Resources.Requests, container.Resources.Limits, extendedResources))
+	}
+
+	// pods with a runtime class overhead are accounted for the overhead in addition
+	// to the sum of their containers
+	if len(podSpec.Overhead) > 0 {
+		table.Add(podResourceRow("Pod Overhead", podSpec.Overhead, podSpec.Overhead, extendedResources))
+
+		requests, limits := podTotalResources(podSpec)
+		total := podResourceRow("Total", requests, limits, extendedResources)
+		for name, col := range map[corev1.ResourceName]string{corev1.ResourceMemory: "Limit: Memory", corev1.ResourceCPU: "Limit: CPU"} {
+			if _, ok := limits[name]; !ok {
+				total[col] = component.NewText("")
+			}
 		}
-		cpuRequest := ""
-		if q := container.Resources.Requests.Cpu(); q != nil {
-			cpuRequest = q.String()
+		table.Add(total)
+	}
+
+	return table, nil
+}
+
+func podResourceRow(name string, requests, limits corev1.ResourceList, extendedResources []corev1.ResourceName) component.TableRow {
+	row := component.TableRow{
+		"Container":       component.NewText(name),
+		"Request: Memory": component.NewText(requests.Memory().String()),
+		"Request: CPU":    component.NewText(requests.Cpu().String()),
+		"Limit: Memory":   component.NewText(limits.Memory().String()),
+		"Limit: CPU":      component.NewText(limits.Cpu().String()),
+	}
+
+	for _, resourceName := range extendedResources {
+		request := ""
+		if q, ok := requests[resourceName]; ok {
+			request = q.String()
 		}
-		memoryLimit := ""
-		if q := container.Resources.Limits.Memory(); q != nil {
-			memoryLimit = q.String()
+		limit := ""
+		if q, ok := limits[resourceName]; ok {
+			limit = q.String()
 		}
-		cpuLimit := ""
-		if q := container.Resources.Limits.Cpu(); q != nil {
-			cpuLimit = q.String()
+
+		row["Request: "+podResourceLabel(resourceName)] = component.NewText(request)
+		row["Limit: "+podResourceLabel(resourceName)] = component.NewText(limit)
+	}
+
+	return row
+}
+
+// podTotalResources returns the sum of a pod's container requests and limits, including
+// the pod overhead. A limit is only totalled if every container sets it.
+func podTotalResources(podSpec corev1.PodSpec) (corev1.ResourceList, corev1.ResourceList) {
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	unlimited := map[corev1.ResourceName]bool{}
+
+	names := append([]corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}, podExtendedResourceNames(podSpec)...)
+
+	for _, container := range podSpec.Containers {
+		for _, name := range names {
+			if q, ok := container.Resources.Requests[name]; ok {
+				total := requests[name]
+				total.Add(q)
+				requests[name] = total
+			}
+
+			q, ok := container.Resources.Limits[name]
+			if !ok {
+				unlimited[name] = true
+				continue
+			}
+			total := limits[name]
+			total.Add(q)
+			limits[name] = total
+		}
+	}
+
+	for name := range unlimited {
+		delete(limits, name)
+	}
+
+	for name, q := range podSpec.Overhead {
+		total := requests[name]
+		total.Add(q)
+		requests[name] = total
+
+		if total, ok := limits[name]; ok {
+			total.Add(q)
+			limits[name] = total
 		}
+	}
+
+	return requests, limits
+}
 
-		row := component.TableRow{
-			"Container":       component.NewText(container.Name),
-			"Request: Memory": component.NewText(memoryRequest),
-			"Request: CPU":    component.NewText(cpuRequest),
-			"Limit: Memory":   component.NewText(memoryLimit),
-			"Limit: CPU":      component.NewText(cpuLimit),
+// podExtendedResourceNames returns the sorted names of resources other than cpu and memory
+// which are requested or limited by a pod's containers.
+func podExtendedResourceNames(podSpec corev1.PodSpec) []corev1.ResourceName {
+	seen := map[corev1.ResourceName]bool{}
+	var names []corev1.ResourceName
+
+	for _, container := range podSpec.Containers {
+		for _, list := range []corev1.ResourceList{container.Resources.Requests, container.Resources.Limits} {
+			for name := range list {
+				if name == corev1.ResourceCPU || name == corev1.ResourceMemory || seen[name] {
+					continue
+				}
+				seen[name] = true
+				names = append(names, name)
+			}
 		}
-		table.Add(row)
 	}
 
-	return table, nil
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+// podResourceLabel returns the column label for a resource. GPU resources
+// provided by device plugins, such as nvidia.com/gpu, are labeled as GPUs.
+func podResourceLabel(name corev1.ResourceName) string {
+	if strings.HasSuffix(string(name), "/gpu") {
+		return fmt.Sprintf("GPU (%s)", name)
+	}
+	return string(name)
 }
 
 type podObject interface {
 	Config(options Options) error
 	Status(options Options) error
 	Conditions(options Options) error
+	SchedulingGates(ctx context.Context, options Options) error
+	NodeConditions(ctx context.Context, options Options) error
+	TopologySpread(ctx context.Context, options Options) error
+	ResourceClaims(ctx context.Context, options Options) error
+	RuntimeClass(ctx context.Context, options Options) error
+	OwnerTree(ctx context.Context, options Options) error
 	InitContainers(ctx context.Context, options Options) error
 	Containers(ctx context.Context, options Options) error
-	Additional(options Options) error
+	Additional(ctx context.Context, options Options) error
+	VolumeDevices(ctx context.Context, options Options) error
 }
 
 type podHandler struct {
-	pod             *corev1.Pod
-	configFunc      func(*corev1.Pod, Options) (*component.Summary, error)
-	summaryFunc     func(*corev1.Pod, Options) (*component.Summary, error)
-	conditionsFunc  func(*corev1.Pod, Options) (*component.Table, error)
-	containerFunc   func(ctx context.Context, pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error)
-	additionalFuncs []func(*corev1.Pod, Options) ObjectPrinterFunc
-	object          *Object
+	pod                *corev1.Pod
+	configFunc         func(*corev1.Pod, Options) (*component.Summary, error)
+	summaryFunc        func(*corev1.Pod, Options) (*component.Summary, error)
+	conditionsFunc     func(*corev1.Pod, Options) (*component.Table, error)
+	nodeConditionsFunc func(context.Context, *corev1.Pod, Options) (component.Component, error)
+	containerFunc      func(ctx context.Context, pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error)
+	additionalFuncs    []func(context.Context, *corev1.Pod, Options) ObjectPrinterFunc
+	object             *Object
 }
 
 var _ podObject = (*podHandler)(nil)
 
-var defaultPodHandlerAdditionalItems = []func(*corev1.Pod, Options) ObjectPrinterFunc{
-	func(pod *corev1.Pod, options Options) ObjectPrinterFunc {
+var defaultPodHandlerAdditionalItems = []func(context.Context, *corev1.Pod, Options) ObjectPrinterFunc{
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
 		return func() (component.Component, error) {
 			return printPodResources(pod.Spec)
 		}
 	},
-	func(pod *corev1.Pod, options Options) ObjectPrinterFunc {
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
 		return func() (component.Component, error) {
-			return printVolumes(pod.Spec.Volumes)
+			return printPodVolumes(ctx, pod, options)
 		}
 	},
-	func(pod *corev1.Pod, options Options) ObjectPrinterFunc {
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
 		return func() (component.Component, error) {
 			return printTolerations(pod.Spec)
 		}
 	},
-	func(pod *corev1.Pod, options Options) ObjectPrinterFunc {
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
 		return func() (component.Component, error) {
 			return printAffinity(pod.Spec)
 		}
 	},
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
+		return func() (component.Component, error) {
+			return printPodDNSConfig(pod)
+		}
+	},
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
+		return func() (component.Component, error) {
+			return createPodContainerLogsView(ctx, pod, options)
+		}
+	},
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
+		return func() (component.Component, error) {
+			return createPodEvictionRiskView(ctx, pod, options)
+		}
+	},
+	func(ctx context.Context, pod *corev1.Pod, options Options) ObjectPrinterFunc {
+		return func() (component.Component, error) {
+			return createSecurityPostureView(ctx, pod, nil, pod.Spec, options)
+		}
+	},
 }
 
 func newPodHandler(pod *corev1.Pod, object *Object) (*podHandler, error) {
@@ -620,13 +936,14 @@ func newPodHandler(pod *corev1.Pod, object *Object) (*podHandler, error) {
 	}
 
 	ph := &podHandler{
-		pod:             pod,
-		configFunc:      defaultPodConfig,
-		summaryFunc:     defaultPodSummary,
-		conditionsFunc:  defaultPodConditions,
-		containerFunc:   defaultPodContainers,
-		additionalFuncs: defaultPodHandlerAdditionalItems,
-		object:          object,
+		pod:                pod,
+		configFunc:         defaultPodConfig,
+		summaryFunc:        defaultPodSummary,
+		conditionsFunc:     defaultPodConditions,
+		nodeConditionsFunc: createPodNodeConditionsView,
+		containerFunc:      defaultPodContainers,
+		additionalFuncs:    defaultPodHandlerAdditionalItems,
+		object:             object,
 	}
 
 	return ph, nil
@@ -679,6 +996,96 @@ func defaultPodConditions(pod *corev1.Pod, options Options) (*component.Table, e
 	return createPodConditionsView(pod)
 }
 
+func (p *podHandler) SchedulingGates(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display scheduling gates for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createPodSchedulingGatesView(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
+func (p *podHandler) NodeConditions(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display node conditions for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return p.nodeConditionsFunc(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
+func (p *podHandler) TopologySpread(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display topology spread for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createTopologySpreadView(ctx, p.pod.Namespace, p.pod.Spec, options)
+		},
+	})
+
+	return nil
+}
+
+func (p *podHandler) ResourceClaims(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display resource claims for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createPodResourceClaimsView(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
+func (p *podHandler) RuntimeClass(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display runtime class for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createPodRuntimeClassView(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
+func (p *podHandler) OwnerTree(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display owner tree for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createOwnerTreeView(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
 func (p *podHandler) InitContainers(ctx context.Context, options Options) error {
 	return p.containers(ctx, p.pod.Spec.InitContainers, true, options)
 }
@@ -712,13 +1119,13 @@ func defaultPodContainers(ctx context.Context, pod *corev1.Pod, container *corev
 	return creator.Create()
 }
 
-func (p *podHandler) Additional(options Options) error {
+func (p *podHandler) Additional(ctx context.Context, options Options) error {
 	var itemDescriptors []ItemDescriptor
 
 	for i := range p.additionalFuncs {
 		itemDescriptors = append(itemDescriptors, ItemDescriptor{
 			Width: component.WidthHalf,
-			Func:  p.additionalFuncs[i](p.pod, options),
+			Func:  p.additionalFuncs[i](ctx, p.pod, options),
 		})
 	}
 
@@ -727,6 +1134,21 @@ func (p *podHandler) Additional(options Options) error {
 	return nil
 }
 
+func (p *podHandler) VolumeDevices(ctx context.Context, options Options) error {
+	if p.pod == nil {
+		return errors.New("can't display volume devices for nil pod")
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return printVolumeDevices(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
 func addPodTableFilters(table *component.Table) {
 	for k, v := range podTableFilters() {
 		table.AddFilter(k, v)
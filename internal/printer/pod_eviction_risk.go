@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// createPodEvictionRiskView summarizes how likely a pod is to be evicted when its node runs
+// short of resources. The risk is derived from the pod's QoS class, whether its containers
+// set both requests and limits, and, when pod metrics are cached, whether its memory usage
+// exceeds its request. Pods using more than they request on a node under memory pressure are
+// at the highest risk along with best effort pods.
+func createPodEvictionRiskView(ctx context.Context, pod *corev1.Pod, options Options) (*component.Summary, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	candidate := evictionCandidate{
+		pod:     *pod,
+		qos:     podQOSClass(*pod),
+		request: podResourceRequest(pod.Spec, corev1.ResourceMemory),
+		usage:   podMemoryUsage(ctx, *pod, options),
+	}
+
+	pressureResource, pressure, err := podNodePressure(ctx, pod, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := component.SummarySections{
+		{Header: "Risk", Content: describePodEvictionRisk(candidate, pressureResource == corev1.ResourceMemory)},
+	}
+	sections.AddText("QoS", string(candidate.qos))
+	sections.AddText("Requests and Limits", describeMissingRequestsAndLimits(pod.Spec))
+
+	if candidate.usage != nil {
+		sections.AddText("Memory Usage", fmt.Sprintf("%s of %s requested", candidate.usage, &candidate.request))
+	} else {
+		sections.AddText("Memory Usage", "Metrics unavailable")
+	}
+
+	if pressure != "" {
+		sections.AddText("Node Pressure", pressure)
+	}
+
+	return component.NewSummary("Eviction Risk", sections...), nil
+}
+
+func describePodEvictionRisk(candidate evictionCandidate, memoryPressure bool) *component.Text {
+	var text *component.Text
+
+	switch candidate.tier() {
+	case 0:
+		text = component.NewText("High: best effort pods are evicted first")
+		text.SetStatus(component.TextStatusError)
+	case 1:
+		if memoryPressure {
+			text = component.NewText("High: memory usage exceeds request on a node under memory pressure")
+			text.SetStatus(component.TextStatusError)
+		} else {
+			text = component.NewText("Elevated: memory usage exceeds request")
+			text.SetStatus(component.TextStatusWarning)
+		}
+	case 3:
+		text = component.NewText("Low: requests match limits")
+		text.SetStatus(component.TextStatusOK)
+	default:
+		text = component.NewText("Medium: evicted after pods exceeding requests")
+	}
+
+	return text
+}
+
+// describeMissingRequestsAndLimits lists the containers which don't set both a request and a
+// limit for CPU and memory.
+func describeMissingRequestsAndLimits(podSpec corev1.PodSpec) string {
+	var missing []string
+	for _, c := range podSpec.Containers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			_, hasRequest := c.Resources.Requests[name]
+			_, hasLimit := c.Resources.Limits[name]
+			if !hasRequest || !hasLimit {
+				missing = append(missing, c.Name)
+				break
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		return "Set for all containers"
+	}
+	return fmt.Sprintf("Not set for %s", strings.Join(missing, ", "))
+}
+
+// podNodePressure returns the resource the pod's node is evicting pods to reclaim, and the
+// pressure conditions it reports. Pods which aren't scheduled, or whose node isn't cached or
+// under pressure, return empty values.
+func podNodePressure(ctx context.Context, pod *corev1.Pod, options Options) (corev1.ResourceName, string, error) {
+	if pod.Spec.NodeName == "" {
+		return "", "", nil
+	}
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "Node",
+		Name:       pod.Spec.NodeName,
+	}
+
+	u, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "get node %s", pod.Spec.NodeName)
+	}
+	if u == nil {
+		return "", "", nil
+	}
+
+	node := &corev1.Node{}
+	if err := kubernetes.FromUnstructured(u, node); err != nil {
+		return "", "", err
+	}
+
+	resourceName, pressure, _ := nodeEvictionResource(node)
+	return resourceName, pressure, nil
+}
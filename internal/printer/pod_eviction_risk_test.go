@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPodEvictionRiskView(t *testing.T) {
+	burstable := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")},
+	}
+
+	guaranteed := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+	}
+
+	podMetrics := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       "PodMetrics",
+		"metadata":   map[string]interface{}{"name": "pod", "namespace": "namespace"},
+		"containers": []interface{}{
+			map[string]interface{}{"name": "app", "usage": map[string]interface{}{"memory": "300Mi"}},
+		},
+	}}
+
+	risk := func(s string, status component.TextStatus) *component.Text {
+		text := component.NewText(s)
+		if status != 0 {
+			text.SetStatus(status)
+		}
+		return text
+	}
+
+	tests := []struct {
+		name       string
+		resources  corev1.ResourceRequirements
+		metrics    *unstructured.Unstructured
+		conditions []corev1.NodeCondition
+		expected   component.SummarySections
+	}{
+		{
+			name: "best effort",
+			expected: component.SummarySections{
+				{Header: "Risk", Content: risk("High: best effort pods are evicted first", component.TextStatusError)},
+				{Header: "QoS", Content: component.NewText("BestEffort")},
+				{Header: "Requests and Limits", Content: component.NewText("Not set for app")},
+				{Header: "Memory Usage", Content: component.NewText("Metrics unavailable")},
+			},
+		},
+		{
+			name:      "burstable without metrics",
+			resources: burstable,
+			expected: component.SummarySections{
+				{Header: "Risk", Content: risk("Medium: evicted after pods exceeding requests", 0)},
+				{Header: "QoS", Content: component.NewText("Burstable")},
+				{Header: "Requests and Limits", Content: component.NewText("Not set for app")},
+				{Header: "Memory Usage", Content: component.NewText("Metrics unavailable")},
+			},
+		},
+		{
+			name:      "burstable exceeding request",
+			resources: burstable,
+			metrics:   podMetrics,
+			expected: component.SummarySections{
+				{Header: "Risk", Content: risk("Elevated: memory usage exceeds request", component.TextStatusWarning)},
+				{Header: "QoS", Content: component.NewText("Burstable")},
+				{Header: "Requests and Limits", Content: component.NewText("Not set for app")},
+				{Header: "Memory Usage", Content: component.NewText("300Mi of 100Mi requested")},
+			},
+		},
+		{
+			name:      "burstable exceeding request under memory pressure",
+			resources: burstable,
+			metrics:   podMetrics,
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue},
+			},
+			expected: component.SummarySections{
+				{Header: "Risk", Content: risk("High: memory usage exceeds request on a node under memory pressure", component.TextStatusError)},
+				{Header: "QoS", Content: component.NewText("Burstable")},
+				{Header: "Requests and Limits", Content: component.NewText("Not set for app")},
+				{Header: "Memory Usage", Content: component.NewText("300Mi of 100Mi requested")},
+				{Header: "Node Pressure", Content: component.NewText("MemoryPressure")},
+			},
+		},
+		{
+			name:      "guaranteed",
+			resources: guaranteed,
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue},
+			},
+			expected: component.SummarySections{
+				{Header: "Risk", Content: risk("Low: requests match limits", component.TextStatusOK)},
+				{Header: "QoS", Content: component.NewText("Guaranteed")},
+				{Header: "Requests and Limits", Content: component.NewText("Set for all containers")},
+				{Header: "Memory Usage", Content: component.NewText("Metrics unavailable")},
+				{Header: "Node Pressure", Content: component.NewText("DiskPressure")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.NodeName = "node"
+			pod.Spec.Containers = []corev1.Container{{Name: "app", Resources: test.resources}}
+
+			node := testutil.CreateNode("node")
+			node.Status.Conditions = test.conditions
+
+			metricsKey := store.Key{Namespace: "namespace", APIVersion: "metrics.k8s.io/v1beta1", Kind: "PodMetrics", Name: "pod"}
+			tpo.objectStore.EXPECT().Get(ctx, metricsKey).Return(test.metrics, nil)
+
+			nodeKey := store.Key{APIVersion: "v1", Kind: "Node", Name: "node"}
+			tpo.objectStore.EXPECT().Get(ctx, nodeKey).Return(testutil.ToUnstructured(t, node), nil)
+
+			got, err := createPodEvictionRiskView(ctx, pod, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, component.NewSummary("Eviction Risk", test.expected...), got)
+		})
+	}
+}
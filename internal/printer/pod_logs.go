@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// DefaultLogSizeWarningBytes is the size of a container's logs at or above which the
+	// container is flagged as flooding its node's disk.
+	DefaultLogSizeWarningBytes = 1 << 30
+)
+
+var (
+	podContainerLogCols = component.NewTableCols("Container", "Log Size", "Rotated")
+)
+
+// ContainerLogStats is the size of a container's logs on its node.
+type ContainerLogStats struct {
+	// UsedBytes is the size of the container's logs, including rotated log files.
+	UsedBytes int64
+	// Rotated is true if the container's logs are being rotated.
+	Rotated bool
+}
+
+// ContainerLogStatsFunc returns the log stats of a pod's containers, keyed by container
+// name. Containers without stats are omitted.
+type ContainerLogStatsFunc func(ctx context.Context, pod *corev1.Pod) (map[string]ContainerLogStats, error)
+
+func (o Options) logSizeWarningBytes() int64 {
+	if o.LogSizeWarningBytes <= 0 {
+		return DefaultLogSizeWarningBytes
+	}
+	return o.LogSizeWarningBytes
+}
+
+// createPodContainerLogsView prints the size of each container's logs and whether they are
+// being rotated. Containers whose logs are at or above the warning size are flagged, as is
+// the rotation of large logs which aren't rotated. Log stats come from ContainerLogStats; if
+// it isn't set, can't get stats, e.g. because node proxy access is forbidden, or has no
+// stats for the pod, no view is returned.
+func createPodContainerLogsView(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	if options.ContainerLogStats == nil {
+		return nil, nil
+	}
+
+	stats, err := options.ContainerLogStats(ctx, pod)
+	if err != nil {
+		log.From(ctx).Errorf("get container log stats for pod %s: %s", pod.Name, err)
+		return nil, nil
+	}
+
+	if len(stats) == 0 {
+		return nil, nil
+	}
+
+	threshold := options.logSizeWarningBytes()
+
+	table := component.NewTable("Container Logs", "There are no container logs!", podContainerLogCols)
+
+	names := append(containerNames(pod.Spec.InitContainers), containerNames(pod.Spec.Containers)...)
+	for _, name := range names {
+		stat, ok := stats[name]
+		if !ok {
+			continue
+		}
+
+		size := component.NewText(resource.NewQuantity(stat.UsedBytes, resource.BinarySI).String())
+		rotated := component.NewText(fmt.Sprintf("%t", stat.Rotated))
+
+		if stat.UsedBytes >= threshold {
+			size.SetStatus(component.TextStatusWarning)
+			if !stat.Rotated {
+				rotated.SetStatus(component.TextStatusWarning)
+			}
+		}
+
+		table.Add(component.TableRow{
+			"Container": component.NewText(name),
+			"Log Size":  size,
+			"Rotated":   rotated,
+		})
+	}
+
+	return table, nil
+}
+
+func containerNames(containers []corev1.Container) []string {
+	var names []string
+	for _, c := range containers {
+		names = append(names, c.Name)
+	}
+	return names
+}
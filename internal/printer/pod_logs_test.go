@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPodContainerLogsView(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.InitContainers = []corev1.Container{{Name: "init"}}
+	pod.Spec.Containers = []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+
+	warning := func(s string) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+
+	tests := []struct {
+		name      string
+		stats     map[string]ContainerLogStats
+		noStats   bool
+		statsErr  error
+		threshold int64
+		expected  func() component.Component
+	}{
+		{
+			name:    "no log stats",
+			noStats: true,
+		},
+		{
+			name:  "no stats for pod",
+			stats: map[string]ContainerLogStats{},
+		},
+		{
+			name:     "stats are unavailable",
+			statsErr: errors.New("forbidden"),
+		},
+		{
+			name: "log sizes",
+			stats: map[string]ContainerLogStats{
+				"app":     {UsedBytes: 10 << 20, Rotated: true},
+				"sidecar": {UsedBytes: 2 << 30},
+				"init":    {UsedBytes: 2 << 30, Rotated: true},
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Container Logs", "There are no container logs!", podContainerLogCols)
+				table.Add(
+					component.TableRow{"Container": component.NewText("init"), "Log Size": warning("2Gi"), "Rotated": component.NewText("true")},
+					component.TableRow{"Container": component.NewText("app"), "Log Size": component.NewText("10Mi"), "Rotated": component.NewText("true")},
+					component.TableRow{"Container": component.NewText("sidecar"), "Log Size": warning("2Gi"), "Rotated": warning("false")},
+				)
+				return table
+			},
+		},
+		{
+			name: "custom warning size",
+			stats: map[string]ContainerLogStats{
+				"app": {UsedBytes: 10 << 20},
+			},
+			threshold: 5 << 20,
+			expected: func() component.Component {
+				table := component.NewTable("Container Logs", "There are no container logs!", podContainerLogCols)
+				table.Add(
+					component.TableRow{"Container": component.NewText("app"), "Log Size": warning("10Mi"), "Rotated": warning("false")},
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			options := tpo.ToOptions()
+			options.LogSizeWarningBytes = test.threshold
+			if !test.noStats {
+				options.ContainerLogStats = func(ctx context.Context, got *corev1.Pod) (map[string]ContainerLogStats, error) {
+					require.Equal(t, pod, got)
+					return test.stats, test.statsErr
+				}
+			}
+
+			got, err := createPodContainerLogsView(ctx, pod, options)
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
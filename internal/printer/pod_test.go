@@ -18,7 +18,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -224,6 +226,86 @@ func TestPodListHandler_sorted(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_podReadyContainers(t *testing.T) {
+	containers := []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+
+	tests := []struct {
+		name     string
+		pod      *corev1.Pod
+		expected *component.Text
+	}{
+		{
+			name: "running with all containers ready",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{Containers: containers},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "app", Ready: true},
+						{Name: "sidecar", Ready: true},
+					},
+				},
+			},
+			expected: component.NewText("2/2"),
+		},
+		{
+			name: "running with a container not ready",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{Containers: containers},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "app", Ready: true},
+						{Name: "sidecar", Ready: false},
+					},
+				},
+			},
+			expected: statusText("1/2", component.TextStatusWarning),
+		},
+		{
+			name: "init containers are not counted",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init"}},
+					Containers:     containers,
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					InitContainerStatuses: []corev1.ContainerStatus{
+						{Name: "init", Ready: true},
+					},
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "app", Ready: true},
+						{Name: "sidecar", Ready: true},
+					},
+				},
+			},
+			expected: component.NewText("2/2"),
+		},
+		{
+			name: "succeeded",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{Containers: containers},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodSucceeded,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "app", Ready: false},
+						{Name: "sidecar", Ready: false},
+					},
+				},
+			},
+			expected: component.NewText("0/2"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := podReadyContainers(test.pod, Options{}.statusThreshold(gvk.Pod))
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
 func Test_PodConfiguration(t *testing.T) {
 	now := testutil.Time()
 	validPod := &corev1.Pod{
@@ -265,10 +347,11 @@ func Test_PodConfiguration(t *testing.T) {
 	nodeLink := component.NewLink("", "node", "/node")
 
 	cases := []struct {
-		name     string
-		pod      *corev1.Pod
-		isErr    bool
-		expected *component.Summary
+		name                 string
+		pod                  *corev1.Pod
+		hideDefaultScheduler bool
+		isErr                bool
+		expected             *component.Summary
 	}{
 		{
 			name: "general",
@@ -292,6 +375,101 @@ func Test_PodConfiguration(t *testing.T) {
 				},
 			}...),
 		},
+		{
+			name: "custom scheduler and preemption policy",
+			pod: func() *corev1.Pod {
+				pod := validPod.DeepCopy()
+				preemptNever := corev1.PreemptNever
+				pod.Spec.PreemptionPolicy = &preemptNever
+				pod.Spec.SchedulerName = "my-scheduler"
+				return pod
+			}(),
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Priority",
+					Content: component.NewText("1000000"),
+				},
+				{
+					Header:  "PriorityClassName",
+					Content: component.NewText("high-priority"),
+				},
+				{
+					Header:  "Preemption Policy",
+					Content: component.NewText("Never"),
+				},
+				{
+					Header: "Scheduler",
+					Content: component.NewText("my-scheduler (not scheduled by default-scheduler)", func(t *component.Text) {
+						t.SetStatus(component.TextStatusWarning)
+					}),
+				},
+				{
+					Header:  "Node",
+					Content: nodeLink,
+				},
+				{
+					Header:  "Service Account",
+					Content: component.NewLink("", "serviceAccount", "/service-account"),
+				},
+			}...),
+		},
+		{
+			name: "default scheduler",
+			pod: func() *corev1.Pod {
+				pod := validPod.DeepCopy()
+				pod.Spec.SchedulerName = corev1.DefaultSchedulerName
+				return pod
+			}(),
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Priority",
+					Content: component.NewText("1000000"),
+				},
+				{
+					Header:  "PriorityClassName",
+					Content: component.NewText("high-priority"),
+				},
+				{
+					Header:  "Scheduler",
+					Content: component.NewText("default-scheduler"),
+				},
+				{
+					Header:  "Node",
+					Content: nodeLink,
+				},
+				{
+					Header:  "Service Account",
+					Content: component.NewLink("", "serviceAccount", "/service-account"),
+				},
+			}...),
+		},
+		{
+			name: "default scheduler is hidden",
+			pod: func() *corev1.Pod {
+				pod := validPod.DeepCopy()
+				pod.Spec.SchedulerName = corev1.DefaultSchedulerName
+				return pod
+			}(),
+			hideDefaultScheduler: true,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Priority",
+					Content: component.NewText("1000000"),
+				},
+				{
+					Header:  "PriorityClassName",
+					Content: component.NewText("high-priority"),
+				},
+				{
+					Header:  "Node",
+					Content: nodeLink,
+				},
+				{
+					Header:  "Service Account",
+					Content: component.NewLink("", "serviceAccount", "/service-account"),
+				},
+			}...),
+		},
 		{
 			name:  "pod is nil",
 			pod:   nil,
@@ -310,6 +488,7 @@ func Test_PodConfiguration(t *testing.T) {
 				Return(nodeLink, nil).AnyTimes()
 
 			printOptions := tpo.ToOptions()
+			printOptions.HideDefaultScheduler = tc.hideDefaultScheduler
 
 			if tc.pod != nil {
 				tpo.PathForObject(tc.pod, tc.pod.Name, "/pod")
@@ -399,6 +578,127 @@ func createPodWithPhase(name string, podLabels map[string]string, phase corev1.P
 	return pod
 }
 
+func Test_createPodNodeConditionsView(t *testing.T) {
+	node := testutil.CreateNode("node")
+
+	tests := []struct {
+		name       string
+		nodeName   string
+		conditions []corev1.NodeCondition
+		notFound   bool
+		expected   func() component.Component
+	}{
+		{
+			name: "pod is not scheduled",
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:     "node is not in the cache",
+			nodeName: "node",
+			notFound: true,
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:     "healthy node",
+			nodeName: "node",
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue, Reason: "KubeletReady"},
+				{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse},
+			},
+			expected: func() component.Component {
+				sections := component.SummarySections{}
+				sections.Add("Node", component.NewLink("", "node", "/node"))
+				sections.AddText("Ready", "True (KubeletReady)")
+				sections.AddText("DiskPressure", "False")
+				return component.NewSummary("Node Conditions", sections...)
+			},
+		},
+		{
+			name:     "node under disk pressure",
+			nodeName: "node",
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+				{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, Reason: "KubeletHasDiskPressure"},
+				{Type: corev1.NodePIDPressure, Status: corev1.ConditionFalse},
+				{Type: corev1.NodeNetworkUnavailable, Status: corev1.ConditionFalse},
+			},
+			expected: func() component.Component {
+				diskPressure := component.NewText("True (KubeletHasDiskPressure)")
+				diskPressure.SetStatus(component.TextStatusWarning)
+
+				sections := component.SummarySections{}
+				sections.Add("Node", component.NewLink("", "node", "/node"))
+				sections.AddText("Ready", "True")
+				sections.AddText("MemoryPressure", "False")
+				sections.Add("DiskPressure", diskPressure)
+				sections.AddText("PIDPressure", "False")
+				summary := component.NewSummary("Node Conditions", sections...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning, "Node node has DiskPressure"))
+				return summary
+			},
+		},
+		{
+			name:     "node is not ready",
+			nodeName: "node",
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Reason: "NodeStatusUnknown"},
+			},
+			expected: func() component.Component {
+				ready := component.NewText("Unknown (NodeStatusUnknown)")
+				ready.SetStatus(component.TextStatusError)
+
+				sections := component.SummarySections{}
+				sections.Add("Node", component.NewLink("", "node", "/node"))
+				sections.Add("Ready", ready)
+				summary := component.NewSummary("Node Conditions", sections...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning, "Node node is not ready"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.NodeName = test.nodeName
+
+			if test.nodeName != "" {
+				key := store.Key{APIVersion: "v1", Kind: "Node", Name: test.nodeName}
+				if test.notFound {
+					tpo.objectStore.EXPECT().Get(ctx, gomock.Eq(key)).Return(nil, nil)
+				} else {
+					n := node.DeepCopy()
+					n.Status.Conditions = test.conditions
+					tpo.objectStore.EXPECT().Get(ctx, gomock.Eq(key)).Return(testutil.ToUnstructured(t, n), nil)
+					tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+				}
+			}
+
+			got, err := createPodNodeConditionsView(ctx, pod, tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
+
 func Test_printPodResources(t *testing.T) {
 	pod := testutil.CreatePod("pod")
 	pod.Spec.Containers = []corev1.Container{
@@ -431,3 +731,137 @@ func Test_printPodResources(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_printPodResources_overhead(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Overhead = corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("120Mi"),
+		corev1.ResourceCPU:    resource.MustParse("250m"),
+	}
+	pod.Spec.Containers = []corev1.Container{
+		{
+			Name: "container-a",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+		{
+			Name: "container-b",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			},
+		},
+	}
+
+	got, err := printPodResources(pod.Spec)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Resources", "Pod has no resource needs", podResourceCols)
+	expected.Add(
+		component.TableRow{
+			"Container":       component.NewText("container-a"),
+			"Request: Memory": component.NewText("128Mi"),
+			"Request: CPU":    component.NewText("500m"),
+			"Limit: Memory":   component.NewText("256Mi"),
+			"Limit: CPU":      component.NewText("0"),
+		},
+		component.TableRow{
+			"Container":       component.NewText("container-b"),
+			"Request: Memory": component.NewText("64Mi"),
+			"Request: CPU":    component.NewText("0"),
+			"Limit: Memory":   component.NewText("64Mi"),
+			"Limit: CPU":      component.NewText("0"),
+		},
+		component.TableRow{
+			"Container":       component.NewText("Pod Overhead"),
+			"Request: Memory": component.NewText("120Mi"),
+			"Request: CPU":    component.NewText("250m"),
+			"Limit: Memory":   component.NewText("120Mi"),
+			"Limit: CPU":      component.NewText("250m"),
+		},
+		component.TableRow{
+			"Container":       component.NewText("Total"),
+			"Request: Memory": component.NewText("312Mi"),
+			"Request: CPU":    component.NewText("750m"),
+			"Limit: Memory":   component.NewText("440Mi"),
+			"Limit: CPU":      component.NewText(""),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_printPodResources_extendedResources(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{
+		{
+			Name: "trainer",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("1"),
+					"nvidia.com/gpu":                resource.MustParse("2"),
+					"example.com/fpga":              resource.MustParse("1"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+				},
+				Limits: corev1.ResourceList{
+					"nvidia.com/gpu": resource.MustParse("2"),
+				},
+			},
+		},
+		{
+			Name: "sidecar",
+		},
+	}
+
+	got, err := printPodResources(pod.Spec)
+	require.NoError(t, err)
+
+	cols := append([]component.TableCol{}, podResourceCols...)
+	cols = append(cols, component.NewTableCols(
+		"Request: ephemeral-storage", "Limit: ephemeral-storage",
+		"Request: example.com/fpga", "Limit: example.com/fpga",
+		"Request: GPU (nvidia.com/gpu)", "Limit: GPU (nvidia.com/gpu)")...)
+
+	expected := component.NewTable("Resources", "Pod has no resource needs", cols)
+	expected.Add(
+		component.TableRow{
+			"Container":                     component.NewText("trainer"),
+			"Request: Memory":               component.NewText("0"),
+			"Request: CPU":                  component.NewText("1"),
+			"Limit: Memory":                 component.NewText("0"),
+			"Limit: CPU":                    component.NewText("0"),
+			"Request: ephemeral-storage":    component.NewText("1Gi"),
+			"Limit: ephemeral-storage":      component.NewText(""),
+			"Request: example.com/fpga":     component.NewText("1"),
+			"Limit: example.com/fpga":       component.NewText(""),
+			"Request: GPU (nvidia.com/gpu)": component.NewText("2"),
+			"Limit: GPU (nvidia.com/gpu)":   component.NewText("2"),
+		},
+		component.TableRow{
+			"Container":                     component.NewText("sidecar"),
+			"Request: Memory":               component.NewText("0"),
+			"Request: CPU":                  component.NewText("0"),
+			"Limit: Memory":                 component.NewText("0"),
+			"Limit: CPU":                    component.NewText("0"),
+			"Request: ephemeral-storage":    component.NewText(""),
+			"Limit: ephemeral-storage":      component.NewText(""),
+			"Request: example.com/fpga":     component.NewText(""),
+			"Limit: example.com/fpga":       component.NewText(""),
+			"Request: GPU (nvidia.com/gpu)": component.NewText(""),
+			"Limit: GPU (nvidia.com/gpu)":   component.NewText(""),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
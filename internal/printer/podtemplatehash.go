@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// podTemplateHashLength is the number of hex digits of a pod template hash.
+	podTemplateHashLength = 16
+)
+
+// podTemplateHash returns a hash of a pod template spec. Equivalent templates have equal
+// hashes: the template is serialized as JSON, which orders map keys, so the hash doesn't
+// depend on map ordering. If the template can't be serialized, the hash is empty.
+func podTemplateHash(spec corev1.PodTemplateSpec) string {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:podTemplateHashLength]
+}
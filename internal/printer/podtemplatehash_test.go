@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_podTemplateHash(t *testing.T) {
+	template := corev1.PodTemplateSpec{}
+	template.Labels = map[string]string{"app": "app", "tier": "web", "version": "1"}
+	template.Annotations = map[string]string{"checksum/config": "abc"}
+	template.Spec.NodeSelector = map[string]string{"disk": "ssd", "zone": "a"}
+	template.Spec.Containers = []corev1.Container{{Name: "app", Image: "nginx:1.15"}}
+
+	equivalent := corev1.PodTemplateSpec{}
+	equivalent.Spec.Containers = []corev1.Container{{Name: "app", Image: "nginx:1.15"}}
+	equivalent.Spec.NodeSelector = map[string]string{"zone": "a", "disk": "ssd"}
+	equivalent.Annotations = map[string]string{"checksum/config": "abc"}
+	equivalent.Labels = map[string]string{"version": "1", "tier": "web", "app": "app"}
+
+	changed := *template.DeepCopy()
+	changed.Spec.Containers[0].Image = "nginx:1.16"
+
+	hash := podTemplateHash(template)
+	require.Len(t, hash, podTemplateHashLength)
+
+	for i := 0; i < 10; i++ {
+		require.Equal(t, hash, podTemplateHash(equivalent))
+	}
+
+	require.NotEqual(t, hash, podTemplateHash(changed))
+	require.NotEqual(t, hash, podTemplateHash(corev1.PodTemplateSpec{}))
+}
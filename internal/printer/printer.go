@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/link"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -26,10 +28,78 @@ import (
 
 // Options provides options to a print handler
 type Options struct {
-	DisableLabels bool
-	DashConfig    config.Dash
-	Link          link.Interface
-	ObjectFactory ObjectFactory
+	DisableLabels          bool
+	DashConfig             config.Dash
+	Link                   link.Interface
+	ObjectFactory          ObjectFactory
+	CustomResourceHandlers *CustomResourceHandlers
+	// DegradedPodStatus counts running pods with containers that are not ready
+	// as Degraded rather than Running in pod status quadrants.
+	DegradedPodStatus bool
+	// HideEmpty omits metadata fields which have no value. They are printed as
+	// "<none>" by default.
+	HideEmpty bool
+	// HideDefaultScheduler omits the scheduler of pods using the default scheduler. It is
+	// printed by default.
+	HideDefaultScheduler bool
+	// HiddenAnnotations are annotation keys which are not printed. Keys ending
+	// in "/" hide every annotation with that prefix.
+	HiddenAnnotations []string
+	// LabelsClickable links each printed label to the list of the object's
+	// kind filtered by that label.
+	LabelsClickable bool
+	// AvailabilityThreshold is the percentage of ready replicas below which a workload's
+	// availability indicator is an error. If zero, DefaultAvailabilityThreshold is used.
+	AvailabilityThreshold int
+	// StatusThresholds overrides the ready percentages at which objects of a kind are
+	// shown as warnings or errors. Use SetStatusThreshold to add validated thresholds.
+	StatusThresholds map[schema.GroupVersionKind]ThresholdConfig
+	// QuotaWarningThreshold is the percentage of a namespace's resource quota at or above
+	// which a resource is flagged as near its limit. If zero, DefaultQuotaWarningThreshold
+	// is used.
+	QuotaWarningThreshold int
+	// AllowedImageRegistries are the registries workloads are expected to pull images from,
+	// e.g. docker.io or registry.example.com:5000. Workloads pulling images from other
+	// registries are flagged. If empty, registries are not flagged.
+	AllowedImageRegistries []string
+	// MinAge excludes objects created more recently than it from lists. If zero, new objects
+	// are not excluded.
+	MinAge time.Duration
+	// MaxAge excludes objects created longer ago than it from lists. If zero, old objects
+	// are not excluded.
+	MaxAge time.Duration
+	// AgeBuckets groups listed objects by age: last hour, last day, last week, or older.
+	AgeBuckets bool
+	// Clock measures object ages. If nil, the real clock is used.
+	Clock clock.Clock
+	// PathResolver resolves link paths before Link does. Plugins use it to route links to
+	// the views of their custom resources. Resource.Print sets it from the dash config. If
+	// nil, links use Link's paths.
+	PathResolver link.PathResolver
+	// ContainerLogStats returns the size of a pod's container logs. Resource.Print sets it
+	// from Resource.SetContainerLogStats. If nil, container log sizes are not printed.
+	ContainerLogStats ContainerLogStatsFunc
+	// LogSizeWarningBytes is the size of a container's logs at or above which the container
+	// is flagged. If zero, DefaultLogSizeWarningBytes is used.
+	LogSizeWarningBytes int64
+	// ServiceReferencePattern finds references to services in container env vars. It needs
+	// service and namespace named groups. If empty, DefaultServiceReferencePattern is used.
+	ServiceReferencePattern string
+	// PinnedFields are the fields shown at the top of the views of objects of a kind,
+	// selected by JSONPath, e.g. .status.phase for a custom resource.
+	PinnedFields map[schema.GroupVersionKind][]PinnedField
+}
+
+// withPathResolver returns options whose Link resolves paths with PathResolver, falling back
+// to the original Link. PathResolver is cleared so links are only wrapped once.
+func (o Options) withPathResolver() Options {
+	if o.PathResolver == nil || o.Link == nil {
+		return o
+	}
+
+	o.Link = link.NewWithPathResolver(o.Link, o.PathResolver)
+	o.PathResolver = nil
+	return o
 }
 
 // Printer is an interface for printing runtime objects.
@@ -40,8 +110,9 @@ type Printer interface {
 
 // Resource prints runtime objects.
 type Resource struct {
-	handlerMap map[reflect.Type]reflect.Value
-	dashConfig config.Dash
+	handlerMap        map[reflect.Type]reflect.Value
+	dashConfig        config.Dash
+	containerLogStats ContainerLogStatsFunc
 }
 
 var _ Printer = (*Resource)(nil)
@@ -63,10 +134,12 @@ func (p *Resource) Print(ctx context.Context, object runtime.Object) (component.
 	}
 
 	printOptions := Options{
-		DashConfig:    p.dashConfig,
-		Link:          l,
-		ObjectFactory: NewDefaultObjectFactory(),
-	}
+		DashConfig:        p.dashConfig,
+		Link:              l,
+		ObjectFactory:     NewDefaultObjectFactory(),
+		PathResolver:      p.dashConfig.PathResolver(),
+		ContainerLogStats: p.containerLogStats,
+	}.withPathResolver()
 
 	t := reflect.TypeOf(object)
 	printFunc, ok := p.handlerMap[t]
@@ -87,6 +160,12 @@ func (p *Resource) Print(ctx context.Context, object runtime.Object) (component.
 	return DefaultPrintFunc(ctx, object, printOptions)
 }
 
+// SetContainerLogStats sets the source of container log sizes printed in pod views, e.g.
+// NewKubeletContainerLogStats. Log sizes are not printed by default.
+func (p *Resource) SetContainerLogStats(fn ContainerLogStatsFunc) {
+	p.containerLogStats = fn
+}
+
 // Handler adds a printer handler.
 // See ValidatePrintHandlerFunc for required method signature.
 func (p *Resource) Handler(printFunc interface{}) error {
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -65,6 +66,7 @@ func Test_Resource_Print(t *testing.T) {
 			defer controller.Finish()
 
 			tpo := newTestPrinterOptions(controller)
+			tpo.dashConfig.EXPECT().PathResolver().Return(nil)
 
 			p := NewResource(tpo.dashConfig)
 
@@ -93,6 +95,43 @@ func Test_Resource_Print(t *testing.T) {
 
 }
 
+func Test_Resource_Print_pathResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	tpo.dashConfig.EXPECT().PathResolver().Return(link.PathResolver(
+		func(namespace, apiVersion, kind, name string) (string, error) {
+			if kind != "Deployment" {
+				return "", nil
+			}
+			return "/plugin/" + namespace + "/" + name, nil
+		}))
+
+	deployment := testutil.CreateDeployment("deployment")
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+	replicaSet.OwnerReferences = testutil.ToOwnerReferences(t, deployment)
+
+	p := NewResource(tpo.dashConfig)
+	require.NoError(t, p.Handler(func(ctx context.Context, rs *appsv1.ReplicaSet, options Options) (component.Component, error) {
+		return defaultReplicaSetConfig(rs, options)
+	}))
+
+	got, err := p.Print(context.Background(), replicaSet)
+	require.NoError(t, err)
+
+	summary, ok := got.(*component.Summary)
+	require.True(t, ok)
+
+	var controlledBy component.Component
+	for _, section := range summary.Sections() {
+		if section.Header == "Controlled By" {
+			controlledBy = section.Content
+		}
+	}
+	component.AssertEqual(t, component.NewLink("", "deployment", "/plugin/namespace/deployment"), controlledBy)
+}
+
 func Test_Resource_Handler(t *testing.T) {
 	cases := []struct {
 		name      string
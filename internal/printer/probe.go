@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// livenessProbeRiskyThreshold is how soon after a container starts a liveness probe can
+	// kill it before the probe is flagged as risky for slow starting containers.
+	livenessProbeRiskyThreshold = 30 * time.Second
+
+	probeDefaultPeriodSeconds    = 10
+	probeDefaultTimeoutSeconds   = 1
+	probeDefaultFailureThreshold = 3
+)
+
+// probeTiming is the effective timing of a container probe.
+type probeTiming struct {
+	// firstCheck is how long after the container starts the probe is first run.
+	firstCheck time.Duration
+	// failAfter is how long after the container starts consecutive failures fail the probe.
+	failAfter time.Duration
+}
+
+// containerProbeTiming returns the effective timing of a probe. A probe fails after failure
+// threshold probes spaced by the period, with the last probe taking up to its timeout.
+// Unset fields use the Kubernetes defaults.
+func containerProbeTiming(probe *corev1.Probe) probeTiming {
+	period := int32OrDefault(probe.PeriodSeconds, probeDefaultPeriodSeconds)
+	timeout := int32OrDefault(probe.TimeoutSeconds, probeDefaultTimeoutSeconds)
+	failureThreshold := int32OrDefault(probe.FailureThreshold, probeDefaultFailureThreshold)
+
+	firstCheck := time.Duration(probe.InitialDelaySeconds) * time.Second
+
+	return probeTiming{
+		firstCheck: firstCheck,
+		failAfter:  firstCheck + time.Duration(period*failureThreshold+timeout)*time.Second,
+	}
+}
+
+func int32OrDefault(value, defaultValue int32) int32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// describeContainerProbes prints the liveness, readiness, and startup probes for a container
+// as statements of when they first check and when they fail. Liveness probes which can kill
+// a container shortly after it starts, without a startup probe to hold them off, are
+// flagged as risky.
+func describeContainerProbes(c *corev1.Container) component.SummarySections {
+	var sections component.SummarySections
+
+	if probe := c.StartupProbe; probe != nil {
+		sections.Add("Startup Probe", describeContainerProbe(probe, "kills container"))
+	}
+
+	if probe := c.LivenessProbe; probe != nil {
+		text := describeContainerProbe(probe, "kills container")
+
+		timing := containerProbeTiming(probe)
+		if c.StartupProbe == nil && timing.failAfter < livenessProbeRiskyThreshold {
+			text = component.NewText(fmt.Sprintf("%s. Risky: a slow starting container is killed within %s",
+				text.Config.Text, duration.HumanDuration(timing.failAfter)))
+			text.SetStatus(component.TextStatusWarning)
+		}
+
+		sections.Add("Liveness Probe", text)
+	}
+
+	if probe := c.ReadinessProbe; probe != nil {
+		sections.Add("Readiness Probe", describeContainerProbe(probe, "marks container not ready"))
+	}
+
+	return sections
+}
+
+func describeContainerProbe(probe *corev1.Probe, failure string) *component.Text {
+	timing := containerProbeTiming(probe)
+
+	return component.NewText(fmt.Sprintf("%s: first check after %s, %s after %s",
+		describeProbeHandler(probe.Handler),
+		duration.HumanDuration(timing.firstCheck),
+		failure,
+		duration.HumanDuration(timing.failAfter)))
+}
+
+func describeProbeHandler(handler corev1.Handler) string {
+	switch {
+	case handler.Exec != nil:
+		return fmt.Sprintf("exec [%s]", strings.Join(handler.Exec.Command, " "))
+	case handler.HTTPGet != nil:
+		httpGet := handler.HTTPGet
+		scheme := strings.ToLower(string(httpGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		return fmt.Sprintf("http-get %s://%s:%s%s", scheme, httpGet.Host, httpGet.Port.String(), httpGet.Path)
+	case handler.TCPSocket != nil:
+		return fmt.Sprintf("tcp-socket %s:%s", handler.TCPSocket.Host, handler.TCPSocket.Port.String())
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_describeContainerProbes(t *testing.T) {
+	httpGet := corev1.Handler{
+		HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+	}
+
+	warning := func(s string) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+
+	tests := []struct {
+		name      string
+		container *corev1.Container
+		expected  component.SummarySections
+	}{
+		{
+			name:      "no probes",
+			container: &corev1.Container{},
+		},
+		{
+			name: "aggressive liveness probe",
+			container: &corev1.Container{
+				LivenessProbe: &corev1.Probe{
+					Handler:          httpGet,
+					PeriodSeconds:    2,
+					TimeoutSeconds:   1,
+					FailureThreshold: 3,
+				},
+			},
+			expected: component.SummarySections{
+				{
+					Header:  "Liveness Probe",
+					Content: warning("http-get http://:8080/healthz: first check after 0s, kills container after 7s. Risky: a slow starting container is killed within 7s"),
+				},
+			},
+		},
+		{
+			name: "liveness probe held off by startup probe",
+			container: &corev1.Container{
+				StartupProbe: &corev1.Probe{
+					Handler:          corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("http")}},
+					FailureThreshold: 30,
+				},
+				LivenessProbe: &corev1.Probe{
+					Handler:       httpGet,
+					PeriodSeconds: 2,
+				},
+				ReadinessProbe: &corev1.Probe{
+					Handler:             corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/ready"}}},
+					InitialDelaySeconds: 5,
+				},
+			},
+			expected: component.SummarySections{
+				{Header: "Startup Probe", Content: component.NewText("tcp-socket :http: first check after 0s, kills container after 5m1s")},
+				{Header: "Liveness Probe", Content: component.NewText("http-get http://:8080/healthz: first check after 0s, kills container after 7s")},
+				{Header: "Readiness Probe", Content: component.NewText("exec [cat /tmp/ready]: first check after 5s, marks container not ready after 36s")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := describeContainerProbes(test.container)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
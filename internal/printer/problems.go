@@ -0,0 +1,362 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// problemTerminatingThreshold is how long an object can be terminating with finalizers
+	// before it is reported as stuck.
+	problemTerminatingThreshold = 5 * time.Minute
+)
+
+var (
+	// problemContainerWaitingReasons are the reasons a waiting container is reported as a
+	// problem rather than as starting.
+	problemContainerWaitingReasons = map[string]bool{
+		"CrashLoopBackOff":           true,
+		"CreateContainerConfigError": true,
+		"CreateContainerError":       true,
+		"ErrImagePull":               true,
+		"ImagePullBackOff":           true,
+		"InvalidImageName":           true,
+	}
+)
+
+// Problem is an issue detected with an object.
+type Problem struct {
+	// Severity is AlertTypeError or AlertTypeWarning.
+	Severity component.AlertType
+	// Message describes the issue.
+	Message string
+}
+
+// createProblemsView prints the issues detected with an object, errors first. Objects
+// without issues show that no issues were detected.
+func createProblemsView(ctx context.Context, object runtime.Object, options Options) (*component.Summary, error) {
+	c := options.Clock
+	if c == nil {
+		c = clock.RealClock{}
+	}
+
+	problems, err := detectProblems(ctx, object, options.DashConfig.ObjectStore(), c.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(problems) == 0 {
+		status := component.NewText("No issues detected")
+		status.SetStatus(component.TextStatusOK)
+
+		return component.NewSummary("Problems", component.SummarySections{
+			{Header: "Status", Content: status},
+		}...), nil
+	}
+
+	var sections component.SummarySections
+	alertType := component.AlertTypeWarning
+
+	for _, problem := range problems {
+		text := component.NewText(problem.Message)
+
+		header := "Warning"
+		text.SetStatus(component.TextStatusWarning)
+		if problem.Severity == component.AlertTypeError {
+			header = "Error"
+			text.SetStatus(component.TextStatusError)
+			alertType = component.AlertTypeError
+		}
+
+		sections.Add(header, text)
+	}
+
+	summary := component.NewSummary("Problems", sections...)
+
+	message := "1 issue detected"
+	if len(problems) > 1 {
+		message = fmt.Sprintf("%d issues detected", len(problems))
+	}
+	summary.SetAlert(component.NewAlert(alertType, message))
+
+	return summary, nil
+}
+
+// detectProblems aggregates the issues detected with an object: failed pods, unbound
+// persistent volume claims, missing secrets and config maps, expired certificates,
+// reconciliation which has stalled, and deletion stuck on finalizers. Errors are sorted
+// before warnings.
+func detectProblems(ctx context.Context, object runtime.Object, objectStore store.Store, now time.Time) ([]Problem, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []Problem
+	add := func(severity component.AlertType, format string, args ...interface{}) {
+		problems = append(problems, Problem{Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if deletionTimestamp := accessor.GetDeletionTimestamp(); deletionTimestamp != nil && len(accessor.GetFinalizers()) > 0 {
+		if terminating := now.Sub(deletionTimestamp.Time); terminating >= problemTerminatingThreshold {
+			add(component.AlertTypeError, "Terminating for %s, blocked by finalizers: %s",
+				duration.HumanDuration(terminating), strings.Join(accessor.GetFinalizers(), ", "))
+		}
+	}
+
+	observed, found, err := objectObservedGeneration(object)
+	if err != nil {
+		return nil, err
+	}
+	if found && observed < accessor.GetGeneration() {
+		add(component.AlertTypeWarning, "Reconciliation stalled: generation %d has not been observed (observed %d)",
+			accessor.GetGeneration(), observed)
+	}
+
+	namespace := accessor.GetNamespace()
+
+	switch o := object.(type) {
+	case *corev1.Pod:
+		for _, message := range podProblems(o) {
+			add(component.AlertTypeError, "%s", message)
+		}
+		if err := addPodSpecProblems(ctx, namespace, o.Spec, objectStore, add); err != nil {
+			return nil, err
+		}
+	case *corev1.PersistentVolumeClaim:
+		if o.Status.Phase != corev1.ClaimBound {
+			add(component.AlertTypeWarning, "PersistentVolumeClaim is %s", persistentVolumeClaimPhase(o))
+		}
+	case *corev1.Secret:
+		if o.Type == corev1.SecretTypeTLS {
+			if notAfter, ok := certificateNotAfter(o.Data[corev1.TLSCertKey]); ok && !now.Before(notAfter) {
+				add(component.AlertTypeError, "Certificate expired %s ago", duration.HumanDuration(now.Sub(notAfter)))
+			}
+		}
+	default:
+		selector, template, ok := workloadPodTemplate(object)
+		if !ok {
+			break
+		}
+
+		pods, err := loadPods(ctx, store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod"}, objectStore, selector)
+		if err != nil {
+			return nil, errors.Wrap(err, "load pods")
+		}
+		for _, pod := range pods {
+			for _, message := range podProblems(pod) {
+				add(component.AlertTypeError, "%s (pod %s)", message, pod.Name)
+			}
+		}
+
+		if err := addPodSpecProblems(ctx, namespace, template.Spec, objectStore, add); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		a, b := problems[i], problems[j]
+		if a.Severity != b.Severity {
+			return a.Severity == component.AlertTypeError
+		}
+		return a.Message < b.Message
+	})
+
+	return problems, nil
+}
+
+// podProblems describes why a pod has failed or which of its containers can't run.
+func podProblems(pod *corev1.Pod) []string {
+	var messages []string
+
+	if pod.Status.Phase == corev1.PodFailed {
+		message := "Pod failed"
+		if reason := pod.Status.Reason; reason != "" {
+			message += ": " + reason
+		}
+		messages = append(messages, message)
+	}
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil && problemContainerWaitingReasons[waiting.Reason] {
+			messages = append(messages, fmt.Sprintf("Container %s is waiting: %s", status.Name, waiting.Reason))
+		}
+	}
+
+	return messages
+}
+
+// addPodSpecProblems reports the persistent volume claims a pod spec mounts which are
+// missing or unbound, and the secrets and config maps it references which are missing.
+func addPodSpecProblems(ctx context.Context, namespace string, spec corev1.PodSpec, objectStore store.Store, add func(component.AlertType, string, ...interface{})) error {
+	for _, volume := range spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		name := volume.PersistentVolumeClaim.ClaimName
+
+		object, err := objectStore.Get(ctx, store.Key{Namespace: namespace, APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: name})
+		if err != nil {
+			return errors.Wrapf(err, "get persistent volume claim %s", name)
+		}
+
+		if object == nil {
+			add(component.AlertTypeError, "PersistentVolumeClaim %s not found", name)
+			continue
+		}
+
+		if phase, _, _ := unstructured.NestedString(object.Object, "status", "phase"); phase != string(corev1.ClaimBound) {
+			if phase == "" {
+				phase = string(corev1.ClaimPending)
+			}
+			add(component.AlertTypeWarning, "PersistentVolumeClaim %s is %s", name, phase)
+		}
+	}
+
+	for _, ref := range podTemplateConfigReferences(spec) {
+		object, err := objectStore.Get(ctx, store.Key{Namespace: namespace, APIVersion: "v1", Kind: ref.kind, Name: ref.name})
+		if err != nil {
+			return errors.Wrapf(err, "get %s %s", ref.kind, ref.name)
+		}
+
+		if object == nil && !configReferenceOptional(spec, ref) {
+			add(component.AlertTypeError, "%s %s not found", ref.kind, ref.name)
+		}
+	}
+
+	return nil
+}
+
+// configReferenceOptional returns true if every use of a config map or secret in a pod spec
+// is optional.
+func configReferenceOptional(spec corev1.PodSpec, ref configReference) bool {
+	optional := true
+	use := func(kind, name string, o *bool) {
+		if kind == ref.kind && name == ref.name && (o == nil || !*o) {
+			optional = false
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if cm := volume.ConfigMap; cm != nil {
+			use("ConfigMap", cm.Name, cm.Optional)
+		}
+		if secret := volume.Secret; secret != nil {
+			use("Secret", secret.SecretName, secret.Optional)
+		}
+		if projected := volume.Projected; projected != nil {
+			for _, source := range projected.Sources {
+				if source.ConfigMap != nil {
+					use("ConfigMap", source.ConfigMap.Name, source.ConfigMap.Optional)
+				}
+				if source.Secret != nil {
+					use("Secret", source.Secret.Name, source.Secret.Optional)
+				}
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, c := range containers {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				use("ConfigMap", envFrom.ConfigMapRef.Name, envFrom.ConfigMapRef.Optional)
+			}
+			if envFrom.SecretRef != nil {
+				use("Secret", envFrom.SecretRef.Name, envFrom.SecretRef.Optional)
+			}
+		}
+
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if keyRef := env.ValueFrom.ConfigMapKeyRef; keyRef != nil {
+				use("ConfigMap", keyRef.Name, keyRef.Optional)
+			}
+			if keyRef := env.ValueFrom.SecretKeyRef; keyRef != nil {
+				use("Secret", keyRef.Name, keyRef.Optional)
+			}
+		}
+	}
+
+	return optional
+}
+
+// workloadPodTemplate returns the selector and pod template of workloads which run pods.
+func workloadPodTemplate(object runtime.Object) (*metav1.LabelSelector, corev1.PodTemplateSpec, bool) {
+	switch o := object.(type) {
+	case *appsv1.Deployment:
+		return o.Spec.Selector, o.Spec.Template, true
+	case *appsv1.StatefulSet:
+		return o.Spec.Selector, o.Spec.Template, true
+	case *appsv1.DaemonSet:
+		return o.Spec.Selector, o.Spec.Template, true
+	case *appsv1.ReplicaSet:
+		return o.Spec.Selector, o.Spec.Template, true
+	case *batchv1.Job:
+		return o.Spec.Selector, o.Spec.Template, true
+	default:
+		return nil, corev1.PodTemplateSpec{}, false
+	}
+}
+
+// objectObservedGeneration returns the generation an object's controller last observed,
+// if the object publishes one in status.observedGeneration.
+func objectObservedGeneration(object runtime.Object) (int64, bool, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "convert object to unstructured")
+	}
+
+	observed, found, err := unstructured.NestedInt64(m, "status", "observedGeneration")
+	if err != nil || !found {
+		return 0, false, nil
+	}
+
+	return observed, true, nil
+}
+
+func persistentVolumeClaimPhase(pvc *corev1.PersistentVolumeClaim) string {
+	if pvc.Status.Phase == "" {
+		return string(corev1.ClaimPending)
+	}
+	return string(pvc.Status.Phase)
+}
+
+// certificateNotAfter returns when the first certificate in a PEM bundle expires.
+func certificateNotAfter(data []byte) (time.Time, bool) {
+	cert, err := parseCertificate(data)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return cert.NotAfter, true
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_detectProblems(t *testing.T) {
+	now := testutil.Time()
+
+	labels := map[string]string{"app": "app"}
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+
+	brokenDeployment := deployment.DeepCopy()
+	brokenDeployment.Generation = 3
+	brokenDeployment.Status.ObservedGeneration = 2
+	brokenDeployment.DeletionTimestamp = &metav1.Time{Time: now.Add(-10 * time.Minute)}
+	brokenDeployment.Finalizers = []string{"example.com/cleanup"}
+	optional := true
+	brokenDeployment.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}}},
+		{Name: "cache", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "cache"}}},
+		{Name: "creds", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "creds"}}},
+		{Name: "extra", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "extra"},
+			Optional:             &optional,
+		}}},
+		{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+		}}},
+	}
+
+	newPod := func(name string) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Labels = labels
+		return pod
+	}
+
+	failedPod := newPod("evicted")
+	failedPod.Status.Phase = corev1.PodFailed
+	failedPod.Status.Reason = "Evicted"
+
+	crashingPod := newPod("crashing")
+	crashingPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: "app", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		{Name: "sidecar", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+	}
+
+	pendingPVC := testutil.CreatePersistentVolumeClaim("cache")
+	pendingPVC.Status.Phase = corev1.ClaimPending
+
+	boundPVC := testutil.CreatePersistentVolumeClaim("bound")
+	boundPVC.Status.Phase = corev1.ClaimBound
+
+	configMap := testutil.CreateConfigMap("config")
+
+	expiredSecret := testutil.CreateSecret("tls")
+	expiredSecret.Type = corev1.SecretTypeTLS
+	expiredSecret.Data = map[string][]byte{corev1.TLSCertKey: createTestCertificate(t, now.Add(-48*time.Hour))}
+
+	validSecret := expiredSecret.DeepCopy()
+	validSecret.Data = map[string][]byte{corev1.TLSCertKey: createTestCertificate(t, now.Add(48*time.Hour))}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		cached   []runtime.Object
+		expected []Problem
+	}{
+		{
+			name:   "healthy deployment",
+			object: deployment,
+			cached: []runtime.Object{newPod("healthy")},
+		},
+		{
+			name:   "deployment with problems",
+			object: brokenDeployment,
+			cached: []runtime.Object{newPod("healthy"), failedPod, crashingPod, pendingPVC, configMap},
+			expected: []Problem{
+				{Severity: component.AlertTypeError, Message: "Container app is waiting: CrashLoopBackOff (pod crashing)"},
+				{Severity: component.AlertTypeError, Message: "PersistentVolumeClaim data not found"},
+				{Severity: component.AlertTypeError, Message: "Pod failed: Evicted (pod evicted)"},
+				{Severity: component.AlertTypeError, Message: "Secret creds not found"},
+				{Severity: component.AlertTypeError, Message: "Terminating for 10m, blocked by finalizers: example.com/cleanup"},
+				{Severity: component.AlertTypeWarning, Message: "PersistentVolumeClaim cache is Pending"},
+				{Severity: component.AlertTypeWarning, Message: "Reconciliation stalled: generation 3 has not been observed (observed 2)"},
+			},
+		},
+		{
+			name:   "crash looping pod",
+			object: crashingPod,
+			expected: []Problem{
+				{Severity: component.AlertTypeError, Message: "Container app is waiting: CrashLoopBackOff"},
+			},
+		},
+		{
+			name:   "pending persistent volume claim",
+			object: pendingPVC,
+			expected: []Problem{
+				{Severity: component.AlertTypeWarning, Message: "PersistentVolumeClaim is Pending"},
+			},
+		},
+		{
+			name:   "bound persistent volume claim",
+			object: boundPVC,
+		},
+		{
+			name:   "expired certificate",
+			object: expiredSecret,
+			expected: []Problem{
+				{Severity: component.AlertTypeError, Message: "Certificate expired 2d ago"},
+			},
+		},
+		{
+			name:   "valid certificate",
+			object: validSecret,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+			stubProblemsStore(t, tpo, test.cached)
+
+			got, err := detectProblems(ctx, test.object, tpo.objectStore, now)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_createProblemsView(t *testing.T) {
+	pvc := testutil.CreatePersistentVolumeClaim("pvc")
+
+	healthy := pvc.DeepCopy()
+	healthy.Status.Phase = corev1.ClaimBound
+
+	unhealthy := pvc.DeepCopy()
+	unhealthy.Status.Phase = corev1.ClaimLost
+	unhealthy.DeletionTimestamp = &metav1.Time{Time: testutil.Time().Add(-time.Hour)}
+	unhealthy.Finalizers = []string{"kubernetes.io/pvc-protection"}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		expected func() *component.Summary
+	}{
+		{
+			name:   "no issues",
+			object: healthy,
+			expected: func() *component.Summary {
+				status := component.NewText("No issues detected")
+				status.SetStatus(component.TextStatusOK)
+				return component.NewSummary("Problems", component.SummarySections{
+					{Header: "Status", Content: status},
+				}...)
+			},
+		},
+		{
+			name:   "issues",
+			object: unhealthy,
+			expected: func() *component.Summary {
+				terminating := component.NewText("Terminating for 60m, blocked by finalizers: kubernetes.io/pvc-protection")
+				terminating.SetStatus(component.TextStatusError)
+				lost := component.NewText("PersistentVolumeClaim is Lost")
+				lost.SetStatus(component.TextStatusWarning)
+
+				summary := component.NewSummary("Problems", component.SummarySections{
+					{Header: "Error", Content: terminating},
+					{Header: "Warning", Content: lost},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeError, "2 issues detected"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			options := tpo.ToOptions()
+			options.Clock = clock.NewFakeClock(testutil.Time())
+
+			got, err := createProblemsView(ctx, test.object, options)
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+// stubProblemsStore serves objects from the object store by kind and name.
+func stubProblemsStore(t *testing.T, tpo *testPrinterOptions, objects []runtime.Object) {
+	list := testutil.ToUnstructuredList(t, objects...)
+
+	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+			filtered := &unstructured.UnstructuredList{}
+			for _, item := range list.Items {
+				if item.GetKind() == key.Kind {
+					filtered.Items = append(filtered.Items, item)
+				}
+			}
+			return filtered, false, nil
+		}).AnyTimes()
+
+	tpo.objectStore.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+			for i := range list.Items {
+				if list.Items[i].GetKind() == key.Kind && list.Items[i].GetName() == key.Name {
+					return &list.Items[i], nil
+				}
+			}
+			return nil, nil
+		}).AnyTimes()
+}
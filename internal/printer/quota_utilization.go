@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// DefaultQuotaWarningThreshold is the percentage of a namespace's quota for a resource
+	// at or above which the resource is flagged as near its limit.
+	DefaultQuotaWarningThreshold = 90
+)
+
+// quotaWarningThreshold returns the quota utilization percentage at which resources are
+// flagged as near their limit.
+func (o Options) quotaWarningThreshold() int {
+	if o.QuotaWarningThreshold > 0 {
+		return o.QuotaWarningThreshold
+	}
+	return DefaultQuotaWarningThreshold
+}
+
+// quotaUtilization is the use of a resource across a namespace's resource quotas.
+type quotaUtilization struct {
+	used resource.Quantity
+	hard resource.Quantity
+}
+
+// createNamespaceQuotaUtilizationView prints the total used and hard amounts of each resource
+// tracked by a namespace's resource quotas. Resources at or above the quota warning threshold
+// are flagged. Namespaces without resource quotas show a note instead.
+func createNamespaceQuotaUtilizationView(ctx context.Context, namespace *corev1.Namespace, options Options) (*component.Summary, error) {
+	if namespace == nil {
+		return nil, errors.New("namespace is nil")
+	}
+
+	key := store.Key{
+		Namespace:  namespace.Name,
+		APIVersion: "v1",
+		Kind:       "ResourceQuota",
+	}
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list resource quotas")
+	}
+
+	var quotas []corev1.ResourceQuota
+	for i := range list.Items {
+		quota := corev1.ResourceQuota{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], &quota); err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+
+	summary := component.NewSummary("Quota Utilization")
+
+	if len(quotas) == 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeInfo, "Namespace has no resource quotas"))
+		return summary, nil
+	}
+
+	utilization := namespaceQuotaUtilization(quotas)
+
+	var names []string
+	for name := range utilization {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	threshold := options.quotaWarningThreshold()
+	bar := component.NewMultiBar("", float64(threshold))
+
+	var nearLimit []string
+	for _, name := range names {
+		u := utilization[name]
+		entry := component.NewMultiBarEntry(name,
+			float64(u.used.MilliValue()),
+			float64(u.hard.MilliValue()),
+			fmt.Sprintf("%s/%s", u.used.String(), u.hard.String()))
+		bar.Add(entry)
+
+		if percent, ok := entry.Percent(); ok && percent >= float64(threshold) {
+			nearLimit = append(nearLimit, name)
+		}
+	}
+
+	summary.AddSection("Resources", bar)
+
+	if len(nearLimit) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Resources at or above %d%% of their quota: %s", threshold, strings.Join(nearLimit, ", "))))
+	}
+
+	return summary, nil
+}
+
+// namespaceQuotaUtilization sums the used and hard amounts of each resource across quotas.
+func namespaceQuotaUtilization(quotas []corev1.ResourceQuota) map[string]quotaUtilization {
+	utilization := make(map[string]quotaUtilization)
+
+	for i := range quotas {
+		status := quotas[i].Status
+		for name, hard := range status.Hard {
+			u := utilization[name.String()]
+			u.hard.Add(hard)
+			if used, ok := status.Used[name]; ok {
+				u.used.Add(used)
+			}
+			utilization[name.String()] = u
+		}
+	}
+
+	return utilization
+}
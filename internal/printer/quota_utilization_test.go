@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createNamespaceQuotaUtilizationView(t *testing.T) {
+	newQuota := func(name string, hard, used corev1.ResourceList) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "namespace"},
+			Status:     corev1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}
+	}
+
+	compute := newQuota("compute",
+		corev1.ResourceList{
+			corev1.ResourceRequestsCPU: resource.MustParse("2"),
+			corev1.ResourcePods:        resource.MustParse("10"),
+		},
+		corev1.ResourceList{
+			corev1.ResourceRequestsCPU: resource.MustParse("500m"),
+			corev1.ResourcePods:        resource.MustParse("9"),
+		})
+	objects := newQuota("objects",
+		corev1.ResourceList{
+			corev1.ResourcePods:    resource.MustParse("10"),
+			corev1.ResourceSecrets: resource.MustParse("5"),
+		},
+		corev1.ResourceList{
+			corev1.ResourcePods: resource.MustParse("9"),
+		})
+
+	tests := []struct {
+		name     string
+		quotas   []runtime.Object
+		options  Options
+		expected func() *component.Summary
+	}{
+		{
+			name: "no quotas",
+			expected: func() *component.Summary {
+				summary := component.NewSummary("Quota Utilization")
+				summary.SetAlert(component.NewAlert(component.AlertTypeInfo, "Namespace has no resource quotas"))
+				return summary
+			},
+		},
+		{
+			name:   "quotas",
+			quotas: []runtime.Object{compute, objects},
+			expected: func() *component.Summary {
+				bar := component.NewMultiBar("", DefaultQuotaWarningThreshold,
+					component.NewMultiBarEntry("pods", 18000, 20000, "18/20"),
+					component.NewMultiBarEntry("requests.cpu", 500, 2000, "500m/2"),
+					component.NewMultiBarEntry("secrets", 0, 5000, "0/5"),
+				)
+
+				summary := component.NewSummary("Quota Utilization", component.SummarySection{
+					Header: "Resources", Content: bar,
+				})
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Resources at or above 90% of their quota: pods"))
+				return summary
+			},
+		},
+		{
+			name:    "custom threshold",
+			quotas:  []runtime.Object{compute},
+			options: Options{QuotaWarningThreshold: 20},
+			expected: func() *component.Summary {
+				bar := component.NewMultiBar("", 20,
+					component.NewMultiBarEntry("pods", 9000, 10000, "9/10"),
+					component.NewMultiBarEntry("requests.cpu", 500, 2000, "500m/2"),
+				)
+
+				summary := component.NewSummary("Quota Utilization", component.SummarySection{
+					Header: "Resources", Content: bar,
+				})
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Resources at or above 20% of their quota: pods, requests.cpu"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ResourceQuota"}
+			tpo.objectStore.EXPECT().List(ctx, key).Return(testutil.ToUnstructuredList(t, test.quotas...), false, nil)
+
+			options := test.options
+			options.DashConfig = tpo.dashConfig
+
+			got, err := createNamespaceQuotaUtilizationView(ctx, testutil.CreateNamespace("namespace"), options)
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
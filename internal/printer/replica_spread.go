@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// replicaSpreadDefaultTopologyKey is the topology key used to show the spread of
+	// workloads without pod anti-affinity.
+	replicaSpreadDefaultTopologyKey = "kubernetes.io/hostname"
+
+	antiAffinityRequired  = "Required"
+	antiAffinityPreferred = "Preferred"
+	antiAffinityNone      = "None"
+)
+
+var (
+	replicaSpreadCols = component.NewTableCols("Topology Key", "Domain", "Replicas", "Anti-Affinity")
+)
+
+// createReplicaSpreadView prints how a workload's scheduled replicas are spread across the
+// topology domains its pod anti-affinity targets. Domains are the values of the topology key
+// on the nodes the replicas run on. Domains with more than one replica despite anti-affinity
+// are flagged: as errors when the anti-affinity is required, and as warnings when it is
+// preferred. Workloads without anti-affinity show their spread across nodes. If the
+// workload has no scheduled replicas, no view is returned.
+func createReplicaSpreadView(ctx context.Context, namespace string, selector *metav1.LabelSelector, template corev1.PodTemplateSpec, options Options) (component.Component, error) {
+	if selector == nil {
+		return nil, nil
+	}
+
+	podSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert workload selector")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	podList, _, err := objectStore.List(ctx, store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	var pods []*corev1.Pod
+	for i := range podList.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&podList.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		if pod.Spec.NodeName == "" || !podSelector.Matches(kLabels.Set(pod.Labels)) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	if len(pods) == 0 {
+		return nil, nil
+	}
+
+	nodeList, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list nodes")
+	}
+
+	nodeLabels := make(map[string]map[string]string)
+	for i := range nodeList.Items {
+		nodeLabels[nodeList.Items[i].GetName()] = nodeList.Items[i].GetLabels()
+	}
+
+	topologyKeys, err := podAntiAffinityTopologyKeys(template)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(topologyKeys) == 0 {
+		topologyKeys[replicaSpreadDefaultTopologyKey] = antiAffinityNone
+	}
+
+	var keys []string
+	for key := range topologyKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := component.NewTable("Replica Spread", "There are no scheduled replicas!", replicaSpreadCols)
+
+	for _, key := range keys {
+		antiAffinity := topologyKeys[key]
+
+		counts := make(map[string]int)
+		for _, pod := range pods {
+			domain, ok := nodeLabels[pod.Spec.NodeName][key]
+			switch {
+			case ok:
+			case key == replicaSpreadDefaultTopologyKey:
+				domain = pod.Spec.NodeName
+			default:
+				domain = "<unknown>"
+			}
+			counts[domain]++
+		}
+
+		var domains []string
+		for domain := range counts {
+			domains = append(domains, domain)
+		}
+		sort.Strings(domains)
+
+		for _, domain := range domains {
+			replicas := component.NewTextf("%d", counts[domain])
+			if counts[domain] > 1 {
+				switch antiAffinity {
+				case antiAffinityRequired:
+					replicas.SetStatus(component.TextStatusError)
+				case antiAffinityPreferred:
+					replicas.SetStatus(component.TextStatusWarning)
+				}
+			}
+
+			table.Add(component.TableRow{
+				"Topology Key":  component.NewText(key),
+				"Domain":        component.NewText(domain),
+				"Replicas":      replicas,
+				"Anti-Affinity": component.NewText(antiAffinity),
+			})
+		}
+	}
+
+	return table, nil
+}
+
+// podAntiAffinityTopologyKeys returns the topology keys of a pod template's anti-affinity
+// terms which select the template's own pods, mapped to whether the anti-affinity is
+// required or preferred. Required anti-affinity takes precedence.
+func podAntiAffinityTopologyKeys(template corev1.PodTemplateSpec) (map[string]string, error) {
+	keys := make(map[string]string)
+
+	affinity := template.Spec.Affinity
+	if affinity == nil || affinity.PodAntiAffinity == nil {
+		return keys, nil
+	}
+
+	selectsSelf := func(term corev1.PodAffinityTerm) (bool, error) {
+		if term.LabelSelector == nil {
+			return false, nil
+		}
+		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("convert anti-affinity selector: %w", err)
+		}
+		return selector.Matches(kLabels.Set(template.Labels)), nil
+	}
+
+	antiAffinity := affinity.PodAntiAffinity
+
+	for _, weighted := range antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		ok, err := selectsSelf(weighted.PodAffinityTerm)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			keys[weighted.PodAffinityTerm.TopologyKey] = antiAffinityPreferred
+		}
+	}
+
+	for _, term := range antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		ok, err := selectsSelf(term)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			keys[term.TopologyKey] = antiAffinityRequired
+		}
+	}
+
+	return keys, nil
+}
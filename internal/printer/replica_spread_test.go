@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createReplicaSpreadView(t *testing.T) {
+	zoneNode := func(name, zone string) *corev1.Node {
+		node := testutil.CreateNode(name)
+		node.Labels = map[string]string{"zone": zone, "kubernetes.io/hostname": name}
+		return node
+	}
+
+	scheduledPod := func(name, nodeName string) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Labels = map[string]string{"app": "web"}
+		pod.Spec.NodeName = nodeName
+		return pod
+	}
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	template := func(antiAffinity *corev1.PodAntiAffinity) corev1.PodTemplateSpec {
+		template := corev1.PodTemplateSpec{}
+		template.Labels = map[string]string{"app": "web"}
+		if antiAffinity != nil {
+			template.Spec.Affinity = &corev1.Affinity{PodAntiAffinity: antiAffinity}
+		}
+		return template
+	}
+
+	nodes := []runtime.Object{
+		zoneNode("node-a", "us-east-1a"),
+		zoneNode("node-b", "us-east-1a"),
+		zoneNode("node-c", "us-east-1b"),
+	}
+
+	pods := []runtime.Object{
+		scheduledPod("web-1", "node-a"),
+		scheduledPod("web-2", "node-b"),
+		scheduledPod("web-3", "node-c"),
+		testutil.CreatePod("unscheduled"),
+	}
+
+	row := func(key, domain string, replicas int, status component.TextStatus, antiAffinity string) component.TableRow {
+		text := component.NewTextf("%d", replicas)
+		if status != 0 {
+			text.SetStatus(status)
+		}
+		return component.TableRow{
+			"Topology Key":  component.NewText(key),
+			"Domain":        component.NewText(domain),
+			"Replicas":      text,
+			"Anti-Affinity": component.NewText(antiAffinity),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		antiAffinity *corev1.PodAntiAffinity
+		pods         []runtime.Object
+		expected     func() component.Component
+	}{
+		{
+			name: "no scheduled replicas",
+			pods: []runtime.Object{testutil.CreatePod("unscheduled")},
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name: "no anti-affinity",
+			pods: pods,
+			expected: func() component.Component {
+				table := component.NewTable("Replica Spread", "There are no scheduled replicas!", replicaSpreadCols)
+				table.Add(
+					row("kubernetes.io/hostname", "node-a", 1, 0, "None"),
+					row("kubernetes.io/hostname", "node-b", 1, 0, "None"),
+					row("kubernetes.io/hostname", "node-c", 1, 0, "None"),
+				)
+				return table
+			},
+		},
+		{
+			name: "replicas share a zone",
+			antiAffinity: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					{LabelSelector: selector, TopologyKey: "kubernetes.io/hostname"},
+				},
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					{Weight: 100, PodAffinityTerm: corev1.PodAffinityTerm{LabelSelector: selector, TopologyKey: "zone"}},
+				},
+			},
+			pods: pods,
+			expected: func() component.Component {
+				table := component.NewTable("Replica Spread", "There are no scheduled replicas!", replicaSpreadCols)
+				table.Add(
+					row("kubernetes.io/hostname", "node-a", 1, 0, "Required"),
+					row("kubernetes.io/hostname", "node-b", 1, 0, "Required"),
+					row("kubernetes.io/hostname", "node-c", 1, 0, "Required"),
+					row("zone", "us-east-1a", 2, component.TextStatusWarning, "Preferred"),
+					row("zone", "us-east-1b", 1, 0, "Preferred"),
+				)
+				return table
+			},
+		},
+		{
+			name: "required anti-affinity violated",
+			antiAffinity: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					{LabelSelector: selector, TopologyKey: "zone"},
+				},
+			},
+			pods: pods,
+			expected: func() component.Component {
+				table := component.NewTable("Replica Spread", "There are no scheduled replicas!", replicaSpreadCols)
+				table.Add(
+					row("zone", "us-east-1a", 2, component.TextStatusError, "Required"),
+					row("zone", "us-east-1b", 1, 0, "Required"),
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			tpo.objectStore.EXPECT().
+				List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}).
+				Return(testutil.ToUnstructuredList(t, test.pods...), false, nil)
+			tpo.objectStore.EXPECT().
+				List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+				Return(testutil.ToUnstructuredList(t, nodes...), false, nil).
+				AnyTimes()
+
+			got, err := createReplicaSpreadView(ctx, "namespace", selector, template(test.antiAffinity), tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
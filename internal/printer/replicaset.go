@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -28,30 +29,16 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	ot := NewObjectTable("ReplicaSets", "We couldn't find any replica sets!", cols, opts.DashConfig.ObjectStore())
+	ot.SetAgeFilter(opts)
+	ot.SetStatusThresholds(opts)
 
-	for _, rs := range list.Items {
-		row := component.TableRow{}
-		nameLink, err := opts.Link.ForObject(&rs, rs.Name)
+	for i := range list.Items {
+		rs := list.Items[i]
+		row, err := ReplicaSetRow(rs, opts)
 		if err != nil {
 			return nil, err
 		}
 
-		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(rs.Labels)
-
-		status := fmt.Sprintf("%d/%d", rs.Status.AvailableReplicas, rs.Status.Replicas)
-		row["Status"] = component.NewText(status)
-
-		ts := rs.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
-
-		containers := component.NewContainers()
-		for _, c := range rs.Spec.Template.Spec.Containers {
-			containers.Add(c.Name, c.Image)
-		}
-		row["Containers"] = containers
-		row["Selector"] = printSelector(rs.Spec.Selector)
-
 		if err := ot.AddRowForObject(ctx, &rs, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -60,10 +47,39 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 	return ot.ToComponent()
 }
 
+// ReplicaSetRow creates a table row for a replica set.
+func ReplicaSetRow(rs appsv1.ReplicaSet, opts Options) (component.TableRow, error) {
+	row := component.TableRow{}
+	nameLink, err := opts.Link.ForObject(&rs, rs.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	row["Name"] = nameLink
+	row["Labels"] = createLabelsView(&rs, rs.Labels, opts)
+
+	status := component.NewTextf("%d/%d", rs.Status.AvailableReplicas, rs.Status.Replicas)
+	setReplicaStatus(status, opts, gvk.AppReplicaSet, int(rs.Status.AvailableReplicas), int(replicasOrDefault(rs.Spec.Replicas)))
+	row["Status"] = status
+
+	ts := rs.CreationTimestamp.Time
+	row["Age"] = component.NewTimestamp(ts)
+
+	containers := component.NewContainers()
+	for _, c := range rs.Spec.Template.Spec.Containers {
+		containers.Add(c.Name, c.Image)
+	}
+	row["Containers"] = containers
+	row["Selector"] = printSelector(rs.Spec.Selector)
+
+	return row, nil
+}
+
 // ReplicaSetHandler is a printFunc that prints a ReplicaSets.
 func ReplicaSetHandler(ctx context.Context, replicaSet *appsv1.ReplicaSet, options Options) (component.Component, error) {
 	o := NewObject(replicaSet)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	rsh, err := newReplicaSetHandler(replicaSet, o)
 	if err != nil {
@@ -105,17 +121,14 @@ func (rc *ReplicaSetConfiguration) Create(options Options) (*component.Summary,
 
 	rs := rc.replicaset
 
-	sections := component.SummarySections{}
+	sections := component.SummarySections(createMetadataSection(rs, options).Config.Sections)
 
 	if controllerRef := metav1.GetControllerOf(rs); controllerRef != nil {
 		controlledBy, err := options.Link.ForOwner(rs, controllerRef)
 		if err != nil {
 			return nil, err
 		}
-		sections = append(sections, component.SummarySection{
-			Header:  "Controlled By",
-			Content: controlledBy,
-		})
+		sections.Add("Controlled By", controlledBy)
 	}
 
 	current := fmt.Sprintf("%d", rs.Status.ReadyReplicas)
@@ -141,6 +154,7 @@ type ReplicaSetStatus struct {
 	selector    *metav1.LabelSelector
 	uid         types.UID
 	objectStore store.Store
+	degraded    bool
 }
 
 // NewReplicaSetStatus creates an instance of ReplicaSetStatus
@@ -154,6 +168,7 @@ func NewReplicaSetStatus(ctx context.Context, replicaSet *appsv1.ReplicaSet, opt
 		selector:    replicaSet.Spec.Selector,
 		uid:         replicaSet.GetUID(),
 		objectStore: options.DashConfig.ObjectStore(),
+		degraded:    options.DegradedPodStatus,
 	}
 }
 
@@ -170,14 +185,25 @@ func (replicaSetStatus *ReplicaSetStatus) Create() (*component.Quadrant, error)
 
 	ps := createPodStatus(pods)
 
+	running := ps.Running
+	if replicaSetStatus.degraded {
+		running -= ps.Degraded
+	}
+
 	quadrant := component.NewQuadrant("Status")
-	if err := quadrant.Set(component.QuadNW, "Running", fmt.Sprintf("%d", ps.Running)); err != nil {
+	if err := quadrant.Set(component.QuadNW, "Running", fmt.Sprintf("%d", running)); err != nil {
 		return nil, errors.New("unable to set quadrant nw")
 	}
 	if err := quadrant.Set(component.QuadNE, "Waiting", fmt.Sprintf("%d", ps.Waiting)); err != nil {
 		return nil, errors.New("unable to set quadrant ne")
 	}
-	if err := quadrant.Set(component.QuadSW, "Succeeded", fmt.Sprintf("%d", ps.Succeeded)); err != nil {
+	if replicaSetStatus.degraded {
+		// Pods in a replica set must have a restart policy of Always, so they never
+		// succeed. The degraded count replaces the succeeded tile.
+		if err := quadrant.Set(component.QuadSW, "Degraded", fmt.Sprintf("%d", ps.Degraded)); err != nil {
+			return nil, errors.New("unable to set quadrant sw")
+		}
+	} else if err := quadrant.Set(component.QuadSW, "Succeeded", fmt.Sprintf("%d", ps.Succeeded)); err != nil {
 		return nil, errors.New("unable to set quadrant sw")
 	}
 	if err := quadrant.Set(component.QuadSE, "Failed", fmt.Sprintf("%d", ps.Failed)); err != nil {
@@ -241,6 +267,13 @@ func (r *replicaSetHandler) Status(ctx context.Context, options Options) error {
 		return errors.New("can't display status for nil replicaset")
 	}
 
+	r.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthQuarter,
+		Func: func() (component.Component, error) {
+			return createWorkloadIndicators(ctx, r.replicaSet, r.replicaSet.Spec.Selector, replicasOrDefault(r.replicaSet.Spec.Replicas), options)
+		},
+	})
+
 	r.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthQuarter,
 		Func: func() (component.Component, error) {
@@ -248,6 +281,20 @@ func (r *replicaSetHandler) Status(ctx context.Context, options Options) error {
 		},
 	})
 
+	r.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createPodAdoptionView(ctx, r.replicaSet, r.replicaSet.Spec.Selector, options)
+		},
+	})
+
+	r.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createOwnerTreeView(ctx, r.replicaSet, options)
+		},
+	})
+
 	return nil
 }
 
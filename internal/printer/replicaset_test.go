@@ -110,6 +110,92 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_ReplicaSetRow(t *testing.T) {
+	now := testutil.Time()
+
+	var replicas int32 = 3
+
+	tests := []struct {
+		name       string
+		replicaSet appsv1.ReplicaSet
+		status     string
+		containers *component.Containers
+	}{
+		{
+			name: "with replicas and status",
+			replicaSet: appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "rs",
+					Namespace:         "default",
+					CreationTimestamp: metav1.Time{Time: now},
+					Labels:            map[string]string{"foo": "bar"},
+				},
+				Spec: appsv1.ReplicaSetSpec{
+					Replicas: &replicas,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "myapp"},
+					},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: "nginx", Image: "nginx:1.15"},
+							},
+						},
+					},
+				},
+				Status: appsv1.ReplicaSetStatus{
+					Replicas:          3,
+					AvailableReplicas: 1,
+				},
+			},
+			status: "1/3",
+			containers: func() *component.Containers {
+				containers := component.NewContainers()
+				containers.Add("nginx", "nginx:1.15")
+				return containers
+			}(),
+		},
+		{
+			name: "nil replicas and missing status",
+			replicaSet: appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "rs",
+					Namespace:         "default",
+					CreationTimestamp: metav1.Time{Time: now},
+				},
+			},
+			status:     "0/0",
+			containers: component.NewContainers(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			tpo.PathForObject(&test.replicaSet, test.replicaSet.Name, "/replica-set")
+
+			got, err := ReplicaSetRow(test.replicaSet, printOptions)
+			require.NoError(t, err)
+
+			expected := component.TableRow{
+				"Name":       component.NewLink("", "rs", "/replica-set"),
+				"Labels":     component.NewLabels(test.replicaSet.Labels),
+				"Status":     component.NewText(test.status),
+				"Age":        component.NewTimestamp(now),
+				"Containers": test.containers,
+				"Selector":   printSelector(test.replicaSet.Spec.Selector),
+			}
+
+			testutil.AssertJSONEqual(t, expected, got)
+		})
+	}
+}
+
 func Test_ReplicaSetConfiguration(t *testing.T) {
 
 	var replicas int32 = 3
@@ -140,6 +226,7 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 	cases := []struct {
 		name       string
 		replicaset *appsv1.ReplicaSet
+		hideEmpty  bool
 		isErr      bool
 		expected   *component.Summary
 	}{
@@ -147,6 +234,34 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 			name:       "replicaset",
 			replicaset: rs,
 			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{Header: "Name", Content: component.NewText("rs-frontend")},
+				{Header: "Namespace", Content: component.NewText("default")},
+				{Header: "UID", Content: component.NewText("<none>")},
+				{Header: "Created", Content: component.NewText("<none>")},
+				{Header: "Generation", Content: component.NewText("<none>")},
+				{Header: "Labels", Content: component.NewText("<none>")},
+				{Header: "Annotations", Content: component.NewText("<none>")},
+				{
+					Header:  "Controlled By",
+					Content: component.NewLink("", "replicaset-controller", "/owner"),
+				},
+				{
+					Header:  "Replica Status",
+					Content: component.NewText("Current 3 / Desired 3"),
+				},
+				{
+					Header:  "Replicas",
+					Content: component.NewText("3"),
+				},
+			}...),
+		},
+		{
+			name:       "replicaset with empty metadata hidden",
+			replicaset: rs,
+			hideEmpty:  true,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{Header: "Name", Content: component.NewText("rs-frontend")},
+				{Header: "Namespace", Content: component.NewText("default")},
 				{
 					Header:  "Controlled By",
 					Content: component.NewLink("", "replicaset-controller", "/owner"),
@@ -175,6 +290,7 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 
 			tpo := newTestPrinterOptions(controller)
 			printOptions := tpo.ToOptions()
+			printOptions.HideEmpty = tc.hideEmpty
 
 			rc := NewReplicaSetConfiguration(tc.replicaset)
 
@@ -195,12 +311,6 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 }
 
 func Test_ReplicaSetStatus(t *testing.T) {
-	controller := gomock.NewController(t)
-	defer controller.Finish()
-
-	tpo := newTestPrinterOptions(controller)
-	printOptions := tpo.ToOptions()
-
 	labels := map[string]string{
 		"app": "myapp",
 	}
@@ -219,38 +329,80 @@ func Test_ReplicaSetStatus(t *testing.T) {
 		},
 	}
 
+	degradedPod := createPodWithPhase("frontend-sl8sv", labels, corev1.PodRunning, metav1.NewControllerRef(rs, rs.GroupVersionKind()))
+	degradedPod.Spec.Containers = []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+	degradedPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: "app", Ready: true},
+		{Name: "sidecar", Ready: false},
+	}
+
 	pods := &corev1.PodList{
 		Items: []corev1.Pod{
 			*createPodWithPhase("frontend-l82ph", labels, corev1.PodRunning, metav1.NewControllerRef(rs, rs.GroupVersionKind())),
 			*createPodWithPhase("frontend-rs95v", labels, corev1.PodRunning, metav1.NewControllerRef(rs, rs.GroupVersionKind())),
-			*createPodWithPhase("frontend-sl8sv", labels, corev1.PodRunning, metav1.NewControllerRef(rs, rs.GroupVersionKind())),
+			*degradedPod,
 		},
 	}
 
-	podList := &unstructured.UnstructuredList{}
-	for _, p := range pods.Items {
-		podList.Items = append(podList.Items, *testutil.ToUnstructured(t, &p))
-	}
-	key := store.Key{
-		Namespace:  "testing",
-		APIVersion: "v1",
-		Kind:       "Pod",
+	tests := []struct {
+		name     string
+		degraded bool
+		expected func() *component.Quadrant
+	}{
+		{
+			name: "phase only",
+			expected: func() *component.Quadrant {
+				expected := component.NewQuadrant("Status")
+				require.NoError(t, expected.Set(component.QuadNW, "Running", "3"))
+				require.NoError(t, expected.Set(component.QuadNE, "Waiting", "0"))
+				require.NoError(t, expected.Set(component.QuadSW, "Succeeded", "0"))
+				require.NoError(t, expected.Set(component.QuadSE, "Failed", "0"))
+				return expected
+			},
+		},
+		{
+			name:     "degraded",
+			degraded: true,
+			expected: func() *component.Quadrant {
+				expected := component.NewQuadrant("Status")
+				require.NoError(t, expected.Set(component.QuadNW, "Running", "2"))
+				require.NoError(t, expected.Set(component.QuadNE, "Waiting", "0"))
+				require.NoError(t, expected.Set(component.QuadSW, "Degraded", "1"))
+				require.NoError(t, expected.Set(component.QuadSE, "Failed", "0"))
+				return expected
+			},
+		},
 	}
 
-	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
 
-	ctx := context.Background()
-	rsc := NewReplicaSetStatus(ctx, rs, printOptions)
-	got, err := rsc.Create()
-	require.NoError(t, err)
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+			printOptions.DegradedPodStatus = test.degraded
+
+			podList := &unstructured.UnstructuredList{}
+			for _, p := range pods.Items {
+				podList.Items = append(podList.Items, *testutil.ToUnstructured(t, &p))
+			}
+			key := store.Key{
+				Namespace:  "testing",
+				APIVersion: "v1",
+				Kind:       "Pod",
+			}
+
+			tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
 
-	expected := component.NewQuadrant("Status")
-	require.NoError(t, expected.Set(component.QuadNW, "Running", "3"))
-	require.NoError(t, expected.Set(component.QuadNE, "Waiting", "0"))
-	require.NoError(t, expected.Set(component.QuadSW, "Succeeded", "0"))
-	require.NoError(t, expected.Set(component.QuadSE, "Failed", "0"))
+			ctx := context.Background()
+			rsc := NewReplicaSetStatus(ctx, rs, printOptions)
+			got, err := rsc.Create()
+			require.NoError(t, err)
 
-	assert.Equal(t, expected, got)
+			assert.Equal(t, test.expected(), got)
+		})
+	}
 }
 
 func Test_ReplicaSetPods(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -28,6 +29,8 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	ot := NewObjectTable("ReplicationControllers",
 		"We couldn't find any replication controllers!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
+	ot.SetStatusThresholds(options)
 
 	for _, rc := range list.Items {
 		row := component.TableRow{}
@@ -38,10 +41,11 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(rc.Labels)
+		row["Labels"] = createLabelsView(&rc, rc.Labels, options)
 
-		status := fmt.Sprintf("%d/%d", rc.Status.AvailableReplicas, rc.Status.Replicas)
-		row["Status"] = component.NewText(status)
+		status := component.NewTextf("%d/%d", rc.Status.AvailableReplicas, rc.Status.Replicas)
+		setReplicaStatus(status, options, gvk.ReplicationController, int(rc.Status.AvailableReplicas), int(replicasOrDefault(rc.Spec.Replicas)))
+		row["Status"] = status
 
 		ts := rc.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
@@ -107,7 +111,7 @@ func (rcc *ReplicationControllerConfiguration) Create(options Options) (*compone
 
 	replicationController := rcc.replicationController
 
-	sections := component.SummarySections{}
+	sections := component.SummarySections(createMetadataSection(replicationController, options).Config.Sections)
 
 	if controllerRef := metav1.GetControllerOf(replicationController); controllerRef != nil {
 		controlledBy, err := options.Link.ForOwner(replicationController, controllerRef)
@@ -251,6 +255,13 @@ func (r *replicationControllerHandler) Status(ctx context.Context, options Optio
 		},
 	})
 
+	r.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createPodAdoptionView(ctx, r.replicationController, &metav1.LabelSelector{MatchLabels: r.replicationController.Spec.Selector}, options)
+		},
+	})
+
 	return nil
 }
 
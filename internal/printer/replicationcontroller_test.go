@@ -123,6 +123,13 @@ func Test_ReplicationControllerConfiguration(t *testing.T) {
 			name:                  "replicationcontroller",
 			replicationController: rc,
 			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{Header: "Name", Content: component.NewText("rc")},
+				{Header: "Namespace", Content: component.NewText("namespace")},
+				{Header: "UID", Content: component.NewText("rc")},
+				{Header: "Created", Content: component.NewText("<none>")},
+				{Header: "Generation", Content: component.NewText("<none>")},
+				{Header: "Labels", Content: component.NewText("<none>")},
+				{Header: "Annotations", Content: component.NewText("<none>")},
 				{
 					Header:  "Replica Status",
 					Content: component.NewText("Current 3 / Desired 3"),
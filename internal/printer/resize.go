@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// resizeRestartPolicyDefault is the restart policy for resources a container's resize
+	// policy doesn't list.
+	resizeRestartPolicyDefault = "NotRequired"
+)
+
+var (
+	// resizeDefaultResources are the resources which can be resized in place.
+	resizeDefaultResources = []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}
+)
+
+// containerResize is the in-place resize configuration and status for a container.
+type containerResize struct {
+	// policy maps resource names to restart policies.
+	policy map[string]string
+	// status is the pod's resize status.
+	status string
+}
+
+// containerResizeSections prints a container's resize policy and, when a resize is in
+// progress, the pod's resize status. Resize policy and status are not part of the core/v1
+// types, so they are read from the cached pod. Pods from clusters which don't support
+// in-place resize have no sections.
+func containerResizeSections(ctx context.Context, pod *corev1.Pod, containerName string, isInit bool, options Options) (component.SummarySections, error) {
+	if pod == nil || options.DashConfig == nil {
+		return nil, nil
+	}
+
+	key := store.Key{
+		Namespace:  pod.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       pod.Name,
+	}
+
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pod")
+	}
+
+	if object == nil {
+		return nil, nil
+	}
+
+	resize, found := podContainerResize(object, containerName, isInit)
+	if !found {
+		return nil, nil
+	}
+
+	var sections component.SummarySections
+
+	var policies []string
+	for _, name := range resizePolicyResourceNames(resize.policy) {
+		policies = append(policies, fmt.Sprintf("%s: %s", name, resize.policy[name]))
+	}
+	sections.AddText("Resize Policy", strings.Join(policies, ", "))
+
+	if resize.status != "" {
+		status := component.NewText(resize.status)
+		switch resize.status {
+		case "Infeasible":
+			status.SetStatus(component.TextStatusError)
+		case "Deferred":
+			status.SetStatus(component.TextStatusWarning)
+		}
+		sections.Add("Resize Status", status)
+	}
+
+	return sections, nil
+}
+
+// podContainerResize returns the resize configuration for a container in a pod. Resources
+// a container's policy doesn't list use the default policy. If no container in the pod has
+// a resize policy, the cluster doesn't support in-place resize and nothing is found.
+func podContainerResize(object *unstructured.Unstructured, containerName string, isInit bool) (containerResize, bool) {
+	field := "containers"
+	if isInit {
+		field = "initContainers"
+	}
+
+	supported := false
+	resize := containerResize{policy: map[string]string{}}
+
+	for _, containersField := range []string{"initContainers", "containers"} {
+		containers, _, _ := unstructured.NestedSlice(object.Object, "spec", containersField)
+		for _, c := range containers {
+			m, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			policies, found, _ := unstructured.NestedSlice(m, "resizePolicy")
+			if !found {
+				continue
+			}
+			supported = true
+
+			if name, _, _ := unstructured.NestedString(m, "name"); name != containerName || containersField != field {
+				continue
+			}
+
+			for _, p := range policies {
+				pm, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				resourceName, _, _ := unstructured.NestedString(pm, "resourceName")
+				restartPolicy, _, _ := unstructured.NestedString(pm, "restartPolicy")
+				if resourceName != "" {
+					resize.policy[resourceName] = restartPolicy
+				}
+			}
+		}
+	}
+
+	if !supported {
+		return containerResize{}, false
+	}
+
+	for _, name := range resizeDefaultResources {
+		if _, ok := resize.policy[name]; !ok {
+			resize.policy[name] = resizeRestartPolicyDefault
+		}
+	}
+
+	resize.status = podResizeStatus(object)
+
+	return resize, true
+}
+
+// podResizeStatus returns a pod's resize status. Older clusters publish it in status.resize,
+// and newer clusters publish it as the PodResizePending and PodResizeInProgress conditions.
+func podResizeStatus(object *unstructured.Unstructured) string {
+	if status, _, _ := unstructured.NestedString(object.Object, "status", "resize"); status != "" {
+		return status
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		status, _, _ := unstructured.NestedString(condition, "status")
+		if status != string(corev1.ConditionTrue) {
+			continue
+		}
+
+		switch conditionType {
+		case "PodResizePending":
+			reason, _, _ := unstructured.NestedString(condition, "reason")
+			return reason
+		case "PodResizeInProgress":
+			return "InProgress"
+		}
+	}
+
+	return ""
+}
+
+// resizePolicyResourceNames returns the resources in a resize policy, with the resources
+// which can be resized by default first.
+func resizePolicyResourceNames(policy map[string]string) []string {
+	names := append([]string{}, resizeDefaultResources...)
+
+	var others []string
+	for name := range policy {
+		if name != string(corev1.ResourceCPU) && name != string(corev1.ResourceMemory) {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+
+	return append(names, others...)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_containerResizeSections(t *testing.T) {
+	statusText := func(value string, status component.TextStatus) *component.Text {
+		text := component.NewText(value)
+		text.SetStatus(status)
+		return text
+	}
+
+	tests := []struct {
+		name          string
+		containerName string
+		resizePolicy  []interface{}
+		status        map[string]interface{}
+		expected      component.SummarySections
+	}{
+		{
+			name:          "resize not supported",
+			containerName: "app",
+		},
+		{
+			name:          "resize policy",
+			containerName: "app",
+			resizePolicy: []interface{}{
+				map[string]interface{}{"resourceName": "memory", "restartPolicy": "RestartContainer"},
+			},
+			expected: component.SummarySections{
+				{Header: "Resize Policy", Content: component.NewText("cpu: NotRequired, memory: RestartContainer")},
+			},
+		},
+		{
+			name:          "default resize policy",
+			containerName: "sidecar",
+			resizePolicy: []interface{}{
+				map[string]interface{}{"resourceName": "memory", "restartPolicy": "RestartContainer"},
+			},
+			expected: component.SummarySections{
+				{Header: "Resize Policy", Content: component.NewText("cpu: NotRequired, memory: NotRequired")},
+			},
+		},
+		{
+			name:          "infeasible resize",
+			containerName: "app",
+			resizePolicy: []interface{}{
+				map[string]interface{}{"resourceName": "cpu", "restartPolicy": "NotRequired"},
+			},
+			status: map[string]interface{}{"resize": "Infeasible"},
+			expected: component.SummarySections{
+				{Header: "Resize Policy", Content: component.NewText("cpu: NotRequired, memory: NotRequired")},
+				{Header: "Resize Status", Content: statusText("Infeasible", component.TextStatusError)},
+			},
+		},
+		{
+			name:          "resize in progress condition",
+			containerName: "app",
+			resizePolicy: []interface{}{
+				map[string]interface{}{"resourceName": "cpu", "restartPolicy": "NotRequired"},
+			},
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "PodResizeInProgress", "status": "True"},
+				},
+			},
+			expected: component.SummarySections{
+				{Header: "Resize Policy", Content: component.NewText("cpu: NotRequired, memory: NotRequired")},
+				{Header: "Resize Status", Content: component.NewText("InProgress")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.Containers = []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+
+			object := testutil.ToUnstructured(t, pod)
+			if test.resizePolicy != nil {
+				containers, _, err := unstructured.NestedSlice(object.Object, "spec", "containers")
+				require.NoError(t, err)
+				containers[0].(map[string]interface{})["resizePolicy"] = test.resizePolicy
+				require.NoError(t, unstructured.SetNestedSlice(object.Object, containers, "spec", "containers"))
+			}
+			for k, v := range test.status {
+				require.NoError(t, unstructured.SetNestedField(object.Object, v, "status", k))
+			}
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+			tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+
+			got, err := containerResizeSections(ctx, pod, test.containerName, false, tpo.ToOptions())
+			require.NoError(t, err)
+
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,493 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// resourceAPIVersion is the API version of dynamic resource allocation objects.
+	resourceAPIVersion = "resource.k8s.io/v1"
+)
+
+var (
+	podResourceClaimCols = component.NewTableCols("Name", "Source", "Claim", "Used By")
+)
+
+// podResourceClaim is a resource claim in a pod's spec.resourceClaims.
+type podResourceClaim struct {
+	name          string
+	claimName     string
+	templateName  string
+	generatedName string
+}
+
+// createPodResourceClaimsView prints the resource claims a pod references and the containers
+// which use them. Resource claims are not part of the core/v1 types, so they are read from the
+// cached pod. If the pod has no resource claims, or they are not supported by the cluster,
+// no view is returned.
+func createPodResourceClaimsView(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	key := store.Key{
+		Namespace:  pod.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       pod.Name,
+	}
+
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pod")
+	}
+
+	if object == nil {
+		return nil, nil
+	}
+
+	claims := podResourceClaims(object)
+	if len(claims) == 0 {
+		return nil, nil
+	}
+
+	usedBy := podResourceClaimConsumers(object)
+
+	table := component.NewTable("Resource Claims", "Pod has no resource claims", podResourceClaimCols)
+
+	for _, claim := range claims {
+		row := component.TableRow{
+			"Name": component.NewText(claim.name),
+		}
+
+		switch {
+		case claim.claimName != "":
+			row["Source"] = resourceClaimLink(pod.Namespace, "ResourceClaim", claim.claimName, options)
+			row["Claim"] = resourceClaimLink(pod.Namespace, "ResourceClaim", claim.claimName, options)
+		case claim.templateName != "":
+			row["Source"] = resourceClaimLink(pod.Namespace, "ResourceClaimTemplate", claim.templateName, options)
+			if claim.generatedName != "" {
+				row["Claim"] = resourceClaimLink(pod.Namespace, "ResourceClaim", claim.generatedName, options)
+			} else {
+				row["Claim"] = component.NewText("<not generated>")
+			}
+		default:
+			row["Source"] = component.NewText("<none>")
+			row["Claim"] = component.NewText("<none>")
+		}
+
+		consumers := usedBy[claim.name]
+		if len(consumers) == 0 {
+			row["Used By"] = component.NewText("<none>")
+		} else {
+			row["Used By"] = component.NewText(strings.Join(consumers, ", "))
+		}
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+// podResourceClaims returns the resource claims in a pod's spec. Both the current layout,
+// where claim names are set on the entry, and the older layout using a source are read.
+func podResourceClaims(object *unstructured.Unstructured) []podResourceClaim {
+	entries, found, err := unstructured.NestedSlice(object.Object, "spec", "resourceClaims")
+	if err != nil || !found {
+		return nil
+	}
+
+	generated := map[string]string{}
+	statuses, _, _ := unstructured.NestedSlice(object.Object, "status", "resourceClaimStatuses")
+	for _, status := range statuses {
+		m, ok := status.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(m, "name")
+		claimName, _, _ := unstructured.NestedString(m, "resourceClaimName")
+		generated[name] = claimName
+	}
+
+	var claims []podResourceClaim
+	for _, entry := range entries {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var claim podResourceClaim
+		claim.name, _, _ = unstructured.NestedString(m, "name")
+		claim.claimName, _, _ = unstructured.NestedString(m, "resourceClaimName")
+		claim.templateName, _, _ = unstructured.NestedString(m, "resourceClaimTemplateName")
+
+		if claim.claimName == "" && claim.templateName == "" {
+			claim.claimName, _, _ = unstructured.NestedString(m, "source", "resourceClaimName")
+			claim.templateName, _, _ = unstructured.NestedString(m, "source", "resourceClaimTemplateName")
+		}
+
+		claim.generatedName = generated[claim.name]
+
+		claims = append(claims, claim)
+	}
+
+	return claims
+}
+
+// podResourceClaimConsumers maps resource claim names to the containers which use them.
+func podResourceClaimConsumers(object *unstructured.Unstructured) map[string][]string {
+	consumers := map[string][]string{}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, _ := unstructured.NestedSlice(object.Object, "spec", field)
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			containerName, _, _ := unstructured.NestedString(container, "name")
+			claims, _, _ := unstructured.NestedSlice(container, "resources", "claims")
+			for _, claim := range claims {
+				m, ok := claim.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _, _ := unstructured.NestedString(m, "name")
+				consumers[name] = append(consumers[name], containerName)
+			}
+		}
+	}
+
+	for name := range consumers {
+		sort.Strings(consumers[name])
+	}
+
+	return consumers
+}
+
+// resourceClaimLink links to a resource claim object. If there is no path for the
+// object, its name is printed instead.
+func resourceClaimLink(namespace, kind, name string, options Options) component.Component {
+	l, err := options.Link.ForGVK(namespace, resourceAPIVersion, kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+	return l
+}
+
+// resourceClaim contains the fields of a resource.k8s.io ResourceClaim which are printed.
+// Dynamic resource allocation types are not part of the vendored Kubernetes API, so they are
+// extracted from the unstructured object.
+type resourceClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   resourceClaimSpec   `json:"spec"`
+	Status resourceClaimStatus `json:"status,omitempty"`
+}
+
+type resourceClaimSpec struct {
+	Devices struct {
+		Requests []deviceRequest `json:"requests,omitempty"`
+	} `json:"devices"`
+}
+
+// deviceRequest is a request for devices. Requests for exactly one kind of device are
+// nested under exactly from resource.k8s.io/v1; earlier versions set the fields inline.
+type deviceRequest struct {
+	Name               string `json:"name"`
+	exactDeviceRequest `json:",inline"`
+	Exactly            *exactDeviceRequest `json:"exactly,omitempty"`
+}
+
+type exactDeviceRequest struct {
+	DeviceClassName string           `json:"deviceClassName,omitempty"`
+	AllocationMode  string           `json:"allocationMode,omitempty"`
+	Count           int64            `json:"count,omitempty"`
+	Selectors       []deviceSelector `json:"selectors,omitempty"`
+}
+
+type deviceSelector struct {
+	CEL *struct {
+		Expression string `json:"expression"`
+	} `json:"cel,omitempty"`
+}
+
+type resourceClaimStatus struct {
+	Allocation  *allocationResult                `json:"allocation,omitempty"`
+	ReservedFor []resourceClaimConsumerReference `json:"reservedFor,omitempty"`
+}
+
+type allocationResult struct {
+	Devices struct {
+		Results []deviceRequestAllocationResult `json:"results,omitempty"`
+	} `json:"devices"`
+}
+
+type deviceRequestAllocationResult struct {
+	Request string `json:"request"`
+	Driver  string `json:"driver"`
+	Pool    string `json:"pool"`
+	Device  string `json:"device"`
+}
+
+type resourceClaimConsumerReference struct {
+	APIGroup string    `json:"apiGroup,omitempty"`
+	Resource string    `json:"resource"`
+	Name     string    `json:"name"`
+	UID      types.UID `json:"uid"`
+}
+
+// exact returns the request for exactly one kind of device.
+func (r deviceRequest) exact() exactDeviceRequest {
+	if r.Exactly != nil {
+		return *r.Exactly
+	}
+	return r.exactDeviceRequest
+}
+
+func toResourceClaim(cr *unstructured.Unstructured) (*resourceClaim, error) {
+	if cr == nil {
+		return nil, errors.New("resource claim is nil")
+	}
+
+	claim := &resourceClaim{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, claim); err != nil {
+		return nil, errors.Wrap(err, "convert resource claim")
+	}
+
+	return claim, nil
+}
+
+// ResourceClaimHandler is a printFunc that prints a ResourceClaim.
+func ResourceClaimHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	claim, err := toResourceClaim(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewObject(cr)
+	o.EnableEvents()
+
+	reservations, err := resourceClaimReservations(ctx, claim, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o.RegisterSummary(createResourceClaimStatus(claim, reservations))
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createDeviceRequestsView(claim, options), nil
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createAllocationResultsView(claim), nil
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createReservedForView(reservations), nil
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+// resourceClaimReservation is a consumer a claim is reserved for. A reservation for a pod
+// which no longer exists is stale.
+type resourceClaimReservation struct {
+	reference resourceClaimConsumerReference
+	view      component.Component
+	stale     bool
+}
+
+// resourceClaimReservations resolves the consumers a claim is reserved for. Pods are linked
+// and checked against the cache.
+func resourceClaimReservations(ctx context.Context, claim *resourceClaim, options Options) ([]resourceClaimReservation, error) {
+	var reservations []resourceClaimReservation
+
+	for _, reference := range claim.Status.ReservedFor {
+		reservation := resourceClaimReservation{
+			reference: reference,
+			view:      component.NewText(reference.Name),
+		}
+
+		if reference.APIGroup == "" && reference.Resource == "pods" {
+			key := store.Key{
+				Namespace:  claim.Namespace,
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       reference.Name,
+			}
+
+			pod, err := options.DashConfig.ObjectStore().Get(ctx, key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get pod %s", reference.Name)
+			}
+
+			reservation.stale = pod == nil || (reference.UID != "" && pod.GetUID() != reference.UID)
+
+			if !reservation.stale {
+				if l, err := options.Link.ForGVK(claim.Namespace, "v1", "Pod", reference.Name, reference.Name); err == nil {
+					reservation.view = l
+				}
+			}
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations, nil
+}
+
+func createResourceClaimStatus(claim *resourceClaim, reservations []resourceClaimReservation) *component.Summary {
+	sections := component.SummarySections{}
+
+	allocation := component.NewText("Pending")
+	allocation.SetStatus(component.TextStatusWarning)
+	if claim.Status.Allocation != nil {
+		allocation = component.NewText("Allocated")
+		allocation.SetStatus(component.TextStatusOK)
+	}
+	sections.Add("Allocation", allocation)
+	sections.AddText("Reserved For", fmt.Sprintf("%d consumers", len(reservations)))
+
+	summary := component.NewSummary("Status", sections...)
+
+	var stale []string
+	for _, reservation := range reservations {
+		if reservation.stale {
+			stale = append(stale, reservation.reference.Name)
+		}
+	}
+
+	if len(stale) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Claim is reserved for pods which no longer exist: %s", strings.Join(stale, ", "))))
+	}
+
+	return summary
+}
+
+var (
+	deviceRequestsCols    = component.NewTableCols("Name", "Device Class", "Allocation Mode", "Count", "Selectors")
+	allocationResultsCols = component.NewTableCols("Request", "Driver", "Pool", "Device")
+	reservedForCols       = component.NewTableCols("Name", "Resource", "Status")
+)
+
+func createDeviceRequestsView(claim *resourceClaim, options Options) *component.Table {
+	table := component.NewTable("Device Requests", "There are no device requests!", deviceRequestsCols)
+
+	for _, request := range claim.Spec.Devices.Requests {
+		exact := request.exact()
+
+		var deviceClass component.Component = component.NewText(exact.DeviceClassName)
+		if l, err := options.Link.ForGVK("", claim.APIVersion, "DeviceClass", exact.DeviceClassName, exact.DeviceClassName); err == nil {
+			deviceClass = l
+		}
+
+		allocationMode := exact.AllocationMode
+		if allocationMode == "" {
+			allocationMode = "ExactCount"
+		}
+
+		count := component.NewTextf("%d", exact.Count)
+		switch {
+		case allocationMode == "All":
+			count = component.NewText("<all>")
+		case exact.Count == 0:
+			count = component.NewText("1")
+		}
+
+		table.Add(component.TableRow{
+			"Name":            component.NewText(request.Name),
+			"Device Class":    deviceClass,
+			"Allocation Mode": component.NewText(allocationMode),
+			"Count":           count,
+			"Selectors":       component.NewText(printDeviceSelectors(exact.Selectors)),
+		})
+	}
+
+	return table
+}
+
+func createAllocationResultsView(claim *resourceClaim) *component.Table {
+	table := component.NewTable("Allocation", "Claim has not been allocated", allocationResultsCols)
+
+	if claim.Status.Allocation == nil {
+		return table
+	}
+
+	for _, result := range claim.Status.Allocation.Devices.Results {
+		table.Add(component.TableRow{
+			"Request": component.NewText(result.Request),
+			"Driver":  component.NewText(result.Driver),
+			"Pool":    component.NewText(result.Pool),
+			"Device":  component.NewText(result.Device),
+		})
+	}
+
+	return table
+}
+
+func createReservedForView(reservations []resourceClaimReservation) *component.Table {
+	table := component.NewTable("Reserved For", "Claim is not reserved", reservedForCols)
+
+	for _, reservation := range reservations {
+		status := component.NewText("Reserved")
+		status.SetStatus(component.TextStatusOK)
+		if reservation.stale {
+			status = component.NewText("Stale (pod no longer exists)")
+			status.SetStatus(component.TextStatusWarning)
+		}
+
+		table.Add(component.TableRow{
+			"Name":     reservation.view,
+			"Resource": component.NewText(reservation.reference.Resource),
+			"Status":   status,
+		})
+	}
+
+	return table
+}
+
+func printDeviceSelectors(selectors []deviceSelector) string {
+	var expressions []string
+	for _, selector := range selectors {
+		if selector.CEL != nil {
+			expressions = append(expressions, selector.CEL.Expression)
+		}
+	}
+
+	if len(expressions) == 0 {
+		return "<none>"
+	}
+
+	return strings.Join(expressions, "; ")
+}
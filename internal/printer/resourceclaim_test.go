@@ -0,0 +1,306 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPodResourceClaimsView(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		status   map[string]interface{}
+		notFound bool
+		setup    func(tpo *testPrinterOptions)
+		expected func() component.Component
+	}{
+		{
+			name:     "pod is not in the cache",
+			notFound: true,
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name: "no resource claims",
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name: "resource claims",
+			spec: map[string]interface{}{
+				"resourceClaims": []interface{}{
+					map[string]interface{}{"name": "gpu", "resourceClaimTemplateName": "gpu-template"},
+					map[string]interface{}{"name": "shared", "resourceClaimName": "shared-claim"},
+					map[string]interface{}{"name": "legacy", "source": map[string]interface{}{"resourceClaimName": "legacy-claim"}},
+				},
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":      "init",
+						"resources": map[string]interface{}{"claims": []interface{}{map[string]interface{}{"name": "shared"}}},
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "trainer",
+						"resources": map[string]interface{}{"claims": []interface{}{
+							map[string]interface{}{"name": "gpu"},
+							map[string]interface{}{"name": "shared"},
+						}},
+					},
+				},
+			},
+			status: map[string]interface{}{
+				"resourceClaimStatuses": []interface{}{
+					map[string]interface{}{"name": "gpu", "resourceClaimName": "pod-gpu-x7k2"},
+				},
+			},
+			setup: func(tpo *testPrinterOptions) {
+				tpo.PathForGVK("namespace", "resource.k8s.io/v1", "ResourceClaimTemplate", "gpu-template", "gpu-template", "/gpu-template")
+				tpo.PathForGVK("namespace", "resource.k8s.io/v1", "ResourceClaim", "pod-gpu-x7k2", "pod-gpu-x7k2", "/pod-gpu-x7k2")
+				tpo.PathForGVK("namespace", "resource.k8s.io/v1", "ResourceClaim", "shared-claim", "shared-claim", "/shared-claim")
+				tpo.link.EXPECT().
+					ForGVK("namespace", "resource.k8s.io/v1", "ResourceClaim", "legacy-claim", "legacy-claim").
+					Return(nil, errors.New("unknown object")).AnyTimes()
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Resource Claims", "Pod has no resource claims", podResourceClaimCols)
+				table.Add(
+					component.TableRow{
+						"Name":    component.NewText("gpu"),
+						"Source":  component.NewLink("", "gpu-template", "/gpu-template"),
+						"Claim":   component.NewLink("", "pod-gpu-x7k2", "/pod-gpu-x7k2"),
+						"Used By": component.NewText("trainer"),
+					},
+					component.TableRow{
+						"Name":    component.NewText("shared"),
+						"Source":  component.NewLink("", "shared-claim", "/shared-claim"),
+						"Claim":   component.NewLink("", "shared-claim", "/shared-claim"),
+						"Used By": component.NewText("init, trainer"),
+					},
+					component.TableRow{
+						"Name":    component.NewText("legacy"),
+						"Source":  component.NewText("legacy-claim"),
+						"Claim":   component.NewText("legacy-claim"),
+						"Used By": component.NewText("<none>"),
+					},
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			pod := testutil.CreatePod("pod")
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+			if test.notFound {
+				tpo.objectStore.EXPECT().Get(ctx, key).Return(nil, nil)
+			} else {
+				object := testutil.ToUnstructured(t, pod)
+				for k, v := range test.spec {
+					require.NoError(t, unstructured.SetNestedField(object.Object, v, "spec", k))
+				}
+				for k, v := range test.status {
+					require.NoError(t, unstructured.SetNestedField(object.Object, v, "status", k))
+				}
+				tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+			}
+
+			if test.setup != nil {
+				test.setup(tpo)
+			}
+
+			got, err := createPodResourceClaimsView(ctx, pod, tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
+
+func Test_resourceClaimReservations(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+	tpo := newTestPrinterOptions(controller)
+
+	claim, err := toResourceClaim(testutil.LoadUnstructuredFromFile(t, "resourceclaim.yaml"))
+	require.NoError(t, err)
+
+	trainer := testutil.CreatePod("trainer")
+	trainer.Namespace = "default"
+	trainer.UID = "trainer-uid"
+
+	tpo.objectStore.EXPECT().
+		Get(ctx, store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "trainer"}).
+		Return(testutil.ToUnstructured(t, trainer), nil)
+	tpo.objectStore.EXPECT().
+		Get(ctx, store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "deleted"}).
+		Return(nil, nil)
+	tpo.PathForGVK("default", "v1", "Pod", "trainer", "trainer", "/trainer")
+
+	reservations, err := resourceClaimReservations(ctx, claim, tpo.ToOptions())
+	require.NoError(t, err)
+
+	got := createReservedForView(reservations)
+
+	reserved := component.NewText("Reserved")
+	reserved.SetStatus(component.TextStatusOK)
+	stale := component.NewText("Stale (pod no longer exists)")
+	stale.SetStatus(component.TextStatusWarning)
+
+	expected := component.NewTable("Reserved For", "Claim is not reserved", reservedForCols)
+	expected.Add(
+		component.TableRow{
+			"Name":     component.NewLink("", "trainer", "/trainer"),
+			"Resource": component.NewText("pods"),
+			"Status":   reserved,
+		},
+		component.TableRow{
+			"Name":     component.NewText("deleted"),
+			"Resource": component.NewText("pods"),
+			"Status":   stale,
+		},
+	)
+	component.AssertEqual(t, expected, got)
+
+	status := createResourceClaimStatus(claim, reservations)
+
+	allocated := component.NewText("Allocated")
+	allocated.SetStatus(component.TextStatusOK)
+
+	expectedStatus := component.NewSummary("Status", component.SummarySections{
+		{Header: "Allocation", Content: allocated},
+		{Header: "Reserved For", Content: component.NewText("2 consumers")},
+	}...)
+	expectedStatus.SetAlert(component.NewAlert(component.AlertTypeWarning,
+		"Claim is reserved for pods which no longer exist: deleted"))
+	component.AssertEqual(t, expectedStatus, status)
+}
+
+func Test_createResourceClaimStatus_pending(t *testing.T) {
+	cr := testutil.LoadUnstructuredFromFile(t, "resourceclaim.yaml")
+	unstructured.RemoveNestedField(cr.Object, "status")
+
+	claim, err := toResourceClaim(cr)
+	require.NoError(t, err)
+
+	got := createResourceClaimStatus(claim, nil)
+
+	pending := component.NewText("Pending")
+	pending.SetStatus(component.TextStatusWarning)
+
+	expected := component.NewSummary("Status", component.SummarySections{
+		{Header: "Allocation", Content: pending},
+		{Header: "Reserved For", Content: component.NewText("0 consumers")},
+	}...)
+	component.AssertEqual(t, expected, got)
+
+	component.AssertEqual(t,
+		component.NewTable("Allocation", "Claim has not been allocated", allocationResultsCols),
+		createAllocationResultsView(claim))
+}
+
+func Test_createDeviceRequestsView(t *testing.T) {
+	v1beta1 := testutil.LoadUnstructuredFromFile(t, "resourceclaim.yaml")
+	v1beta1.SetAPIVersion("resource.k8s.io/v1beta1")
+	require.NoError(t, unstructured.SetNestedSlice(v1beta1.Object, []interface{}{
+		map[string]interface{}{"name": "gpu", "deviceClassName": "gpu.example.com", "count": int64(2)},
+	}, "spec", "devices", "requests"))
+
+	tests := []struct {
+		name     string
+		cr       *unstructured.Unstructured
+		setup    func(tpo *testPrinterOptions)
+		expected []component.TableRow
+	}{
+		{
+			name: "v1",
+			cr:   testutil.LoadUnstructuredFromFile(t, "resourceclaim.yaml"),
+			setup: func(tpo *testPrinterOptions) {
+				tpo.PathForGVK("", "resource.k8s.io/v1", "DeviceClass", "gpu.example.com", "gpu.example.com", "/gpu")
+				tpo.PathForGVK("", "resource.k8s.io/v1", "DeviceClass", "nic.example.com", "nic.example.com", "/nic")
+			},
+			expected: []component.TableRow{
+				{
+					"Name":            component.NewText("gpu"),
+					"Device Class":    component.NewLink("", "gpu.example.com", "/gpu"),
+					"Allocation Mode": component.NewText("ExactCount"),
+					"Count":           component.NewText("1"),
+					"Selectors":       component.NewText(`device.attributes["gpu.example.com"].memory >= 16`),
+				},
+				{
+					"Name":            component.NewText("nic"),
+					"Device Class":    component.NewLink("", "nic.example.com", "/nic"),
+					"Allocation Mode": component.NewText("All"),
+					"Count":           component.NewText("<all>"),
+					"Selectors":       component.NewText("<none>"),
+				},
+			},
+		},
+		{
+			name: "v1beta1",
+			cr:   v1beta1,
+			setup: func(tpo *testPrinterOptions) {
+				tpo.PathForGVK("", "resource.k8s.io/v1beta1", "DeviceClass", "gpu.example.com", "gpu.example.com", "/gpu")
+			},
+			expected: []component.TableRow{
+				{
+					"Name":            component.NewText("gpu"),
+					"Device Class":    component.NewLink("", "gpu.example.com", "/gpu"),
+					"Allocation Mode": component.NewText("ExactCount"),
+					"Count":           component.NewText("2"),
+					"Selectors":       component.NewText("<none>"),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			test.setup(tpo)
+
+			claim, err := toResourceClaim(test.cr)
+			require.NoError(t, err)
+
+			got := createDeviceRequestsView(claim, tpo.ToOptions())
+
+			expected := component.NewTableWithRows("Device Requests", "There are no device requests!", deviceRequestsCols, test.expected)
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
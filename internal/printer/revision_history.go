@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	revisionStatusCurrent  = "Current"
+	revisionStatusUpdating = "Updating"
+)
+
+var (
+	revisionHistoryCols = component.NewTableCols("Revision", "Name", "Age", "Status", "Changes")
+)
+
+// createRevisionHistoryView prints the update history of a workload from the controller
+// revisions it controls, newest first. Revisions named by currentRevision or updateRevision
+// are marked; workloads which don't publish their revisions, e.g. daemon sets, have their
+// newest revision marked as current. Each revision lists how its pod template changed from
+// the revision before it, linked to a comparison of the two revisions.
+func createRevisionHistoryView(ctx context.Context, object metav1.Object, currentRevision, updateRevision string, options Options) (component.Component, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: "apps/v1",
+		Kind:       "ControllerRevision",
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list controller revisions")
+	}
+
+	var revisions []*appsv1.ControllerRevision
+	for i := range list.Items {
+		revision := &appsv1.ControllerRevision{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], revision); err != nil {
+			return nil, err
+		}
+
+		controllerRef := metav1.GetControllerOf(revision)
+		if controllerRef == nil || controllerRef.UID != object.GetUID() {
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+
+	if currentRevision == "" && updateRevision == "" && len(revisions) > 0 {
+		currentRevision = revisions[0].Name
+	}
+
+	table := component.NewTable("Revision History", "There are no revisions!", revisionHistoryCols)
+
+	for i, revision := range revisions {
+		var previous *appsv1.ControllerRevision
+		if i+1 < len(revisions) {
+			previous = revisions[i+1]
+		}
+
+		description, err := describeRevisionChanges(previous, revision)
+		if err != nil {
+			return nil, err
+		}
+
+		var changes component.Component = component.NewText(description)
+		if previous != nil {
+			changes = component.NewLink("", description, revisionComparePath(revision.Namespace, previous.Name, revision.Name))
+		}
+
+		table.Add(component.TableRow{
+			"Revision": component.NewTextf("%d", revision.Revision),
+			"Name":     component.NewText(revision.Name),
+			"Age":      component.NewTimestamp(revision.CreationTimestamp.Time),
+			"Status":   revisionStatus(revision.Name, currentRevision, updateRevision),
+			"Changes":  changes,
+		})
+	}
+
+	return table, nil
+}
+
+// revisionComparePath is the path of the view comparing a revision with the previous one.
+func revisionComparePath(namespace, previous, revision string) string {
+	return path.Join("/overview/namespace", namespace, "compare/controller-revisions", previous, revision)
+}
+
+// revisionStatus marks the revision the workload's pods run and the revision they are
+// being updated to.
+func revisionStatus(name, currentRevision, updateRevision string) *component.Text {
+	switch {
+	case name == currentRevision:
+		text := component.NewText(revisionStatusCurrent)
+		text.SetStatus(component.TextStatusOK)
+		return text
+	case name == updateRevision:
+		text := component.NewText(revisionStatusUpdating)
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	default:
+		return component.NewText("")
+	}
+}
+
+// describeRevisionChanges describes how a revision's pod template differs from the
+// previous revision's, naming containers whose images were changed, added, or removed.
+func describeRevisionChanges(previous, revision *appsv1.ControllerRevision) (string, error) {
+	if previous == nil {
+		return "Initial revision", nil
+	}
+
+	before, err := revisionPodTemplate(previous)
+	if err != nil {
+		return "", err
+	}
+
+	after, err := revisionPodTemplate(revision)
+	if err != nil {
+		return "", err
+	}
+
+	beforeImages := revisionImages(before)
+	afterImages := revisionImages(after)
+
+	var changes []string
+	for _, c := range revisionContainers(after) {
+		image, ok := beforeImages[c.Name]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("added %s (%s)", c.Name, c.Image))
+		case image != c.Image:
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", c.Name, image, c.Image))
+		}
+	}
+
+	for _, c := range revisionContainers(before) {
+		if _, ok := afterImages[c.Name]; !ok {
+			changes = append(changes, fmt.Sprintf("removed %s", c.Name))
+		}
+	}
+
+	if len(changes) == 0 {
+		return "Pod template changed", nil
+	}
+
+	return strings.Join(changes, ", "), nil
+}
+
+// revisionPodTemplate returns the pod template stored in a controller revision. Stateful
+// set and daemon set revisions store their template as a patch of spec.template.
+func revisionPodTemplate(revision *appsv1.ControllerRevision) (corev1.PodTemplateSpec, error) {
+	var data struct {
+		Spec struct {
+			Template corev1.PodTemplateSpec `json:"template"`
+		} `json:"spec"`
+	}
+
+	if len(revision.Data.Raw) == 0 {
+		return corev1.PodTemplateSpec{}, nil
+	}
+
+	if err := json.Unmarshal(revision.Data.Raw, &data); err != nil {
+		return corev1.PodTemplateSpec{}, errors.Wrapf(err, "decode controller revision %s", revision.Name)
+	}
+
+	return data.Spec.Template, nil
+}
+
+func revisionContainers(template corev1.PodTemplateSpec) []corev1.Container {
+	return append(append([]corev1.Container{}, template.Spec.InitContainers...), template.Spec.Containers...)
+}
+
+func revisionImages(template corev1.PodTemplateSpec) map[string]string {
+	images := make(map[string]string)
+	for _, c := range revisionContainers(template) {
+		images[c.Name] = c.Image
+	}
+	return images
+}
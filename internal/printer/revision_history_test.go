@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createRevisionHistoryView(t *testing.T) {
+	statefulSet := testutil.CreateStatefulSet("web")
+	other := testutil.CreateStatefulSet("other")
+	other.UID = "other"
+
+	newRevision := func(name string, revision int64, owner metav1.Object, images ...string) *appsv1.ControllerRevision {
+		var containers []corev1.Container
+		for i, image := range images {
+			containers = append(containers, corev1.Container{Name: []string{"app", "sidecar"}[i], Image: image})
+		}
+
+		var data struct {
+			Spec struct {
+				Template corev1.PodTemplateSpec `json:"template"`
+			} `json:"spec"`
+		}
+		data.Spec.Template.Spec.Containers = containers
+
+		raw, err := json.Marshal(&data)
+		require.NoError(t, err)
+
+		controllerRevision := &appsv1.ControllerRevision{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ControllerRevision"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "namespace",
+				CreationTimestamp: metav1.Time{Time: testutil.Time()},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(owner, appsv1.SchemeGroupVersion.WithKind("StatefulSet")),
+				},
+			},
+			Data:     runtime.RawExtension{Raw: raw},
+			Revision: revision,
+		}
+		return controllerRevision
+	}
+
+	row := func(revision, name string, status *component.Text, changes component.Component) component.TableRow {
+		return component.TableRow{
+			"Revision": component.NewText(revision),
+			"Name":     component.NewText(name),
+			"Age":      component.NewTimestamp(testutil.Time()),
+			"Status":   status,
+			"Changes":  changes,
+		}
+	}
+
+	compare := func(changes, previous, revision string) *component.Link {
+		return component.NewLink("", changes,
+			"/overview/namespace/namespace/compare/controller-revisions/"+previous+"/"+revision)
+	}
+
+	current := component.NewText("Current")
+	current.SetStatus(component.TextStatusOK)
+
+	updating := component.NewText("Updating")
+	updating.SetStatus(component.TextStatusWarning)
+
+	tests := []struct {
+		name            string
+		revisions       []runtime.Object
+		currentRevision string
+		updateRevision  string
+		expected        func() component.Component
+	}{
+		{
+			name: "no revisions",
+			revisions: []runtime.Object{
+				newRevision("other-1", 1, other, "nginx:1.14"),
+			},
+			expected: func() component.Component {
+				return component.NewTable("Revision History", "There are no revisions!", revisionHistoryCols)
+			},
+		},
+		{
+			name: "rolling update in progress",
+			revisions: []runtime.Object{
+				newRevision("web-1", 1, statefulSet, "nginx:1.14"),
+				newRevision("web-3", 3, statefulSet, "nginx:1.15", "envoy:1.0"),
+				newRevision("web-2", 2, statefulSet, "nginx:1.15"),
+				newRevision("other-1", 1, other, "nginx:1.14"),
+			},
+			currentRevision: "web-2",
+			updateRevision:  "web-3",
+			expected: func() component.Component {
+				table := component.NewTable("Revision History", "There are no revisions!", revisionHistoryCols)
+				table.Add(
+					row("3", "web-3", updating, compare("added sidecar (envoy:1.0)", "web-2", "web-3")),
+					row("2", "web-2", current, compare("app: nginx:1.14 → nginx:1.15", "web-1", "web-2")),
+					row("1", "web-1", component.NewText(""), component.NewText("Initial revision")),
+				)
+				return table
+			},
+		},
+		{
+			name: "no published revisions marks newest revision current",
+			revisions: []runtime.Object{
+				newRevision("web-1", 1, statefulSet, "nginx:1.14"),
+				newRevision("web-2", 2, statefulSet, "nginx:1.14"),
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Revision History", "There are no revisions!", revisionHistoryCols)
+				table.Add(
+					row("2", "web-2", current, compare("Pod template changed", "web-1", "web-2")),
+					row("1", "web-1", component.NewText(""), component.NewText("Initial revision")),
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ControllerRevision"}
+			tpo.objectStore.EXPECT().List(ctx, key).Return(testutil.ToUnstructuredList(t, test.revisions...), false, nil)
+
+			got, err := createRevisionHistoryView(ctx, statefulSet, test.currentRevision, test.updateRevision, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
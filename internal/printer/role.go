@@ -24,6 +24,7 @@ func RoleListHandler(ctx context.Context, roleList *rbacv1.RoleList, options Opt
 
 	columns := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Roles", "We couldn't find any roles!", columns, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, role := range roleList.Items {
 		row := component.TableRow{}
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// runtimeClassAPIVersion is the API version of RuntimeClass objects.
+	runtimeClassAPIVersion = "node.k8s.io/v1"
+)
+
+// createPodRuntimeClassView prints a pod's runtime class and the resource overhead it adds
+// to the pod. The runtime class is linked if it is in the cache. Pods without a runtime
+// class have no view.
+func createPodRuntimeClassView(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	if pod.Spec.RuntimeClassName == nil || *pod.Spec.RuntimeClassName == "" {
+		return nil, nil
+	}
+
+	name := *pod.Spec.RuntimeClassName
+
+	key := store.Key{
+		APIVersion: runtimeClassAPIVersion,
+		Kind:       "RuntimeClass",
+		Name:       name,
+	}
+
+	runtimeClass, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get runtime class %s", name)
+	}
+
+	sections := component.SummarySections{}
+
+	if runtimeClass == nil {
+		sections.AddText("Runtime Class", name)
+	} else {
+		var view component.Component = component.NewText(name)
+		if l, err := options.Link.ForGVK("", runtimeClassAPIVersion, "RuntimeClass", name, name); err == nil {
+			view = l
+		}
+		sections.Add("Runtime Class", view)
+
+		if handler, _, _ := unstructured.NestedString(runtimeClass.Object, "handler"); handler != "" {
+			sections.AddText("Handler", handler)
+		}
+	}
+
+	sections.AddText("Overhead", formatResourceList(pod.Spec.Overhead))
+
+	return component.NewSummary("Runtime", sections...), nil
+}
+
+var (
+	runtimeClassListCols = component.NewTableCols("Name", "Handler", "Age")
+	runtimeClassPodsCols = component.NewTableCols("Namespace", "Name", "Node")
+)
+
+// RuntimeClassListHandler is a printFunc that prints runtime classes
+func RuntimeClassListHandler(ctx context.Context, list *nodev1beta1.RuntimeClassList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("runtime class list is nil")
+	}
+
+	ot := NewObjectTable("Runtime Classes", "We couldn't find any runtime classes!", runtimeClassListCols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
+
+	for i := range list.Items {
+		runtimeClass := &list.Items[i]
+
+		nameLink, err := options.Link.ForObject(runtimeClass, runtimeClass.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row := component.TableRow{
+			"Name":    nameLink,
+			"Handler": component.NewText(runtimeClass.Handler),
+			"Age":     component.NewTimestamp(runtimeClass.CreationTimestamp.Time),
+		}
+
+		if err := ot.AddRowForObject(ctx, runtimeClass, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// RuntimeClassHandler is a printFunc that prints a runtime class
+func RuntimeClassHandler(ctx context.Context, runtimeClass *nodev1beta1.RuntimeClass, options Options) (component.Component, error) {
+	if runtimeClass == nil {
+		return nil, errors.New("runtime class is nil")
+	}
+
+	o := NewObject(runtimeClass)
+
+	o.RegisterConfig(createRuntimeClassConfiguration(runtimeClass))
+
+	if scheduling := runtimeClass.Scheduling; scheduling != nil && len(scheduling.Tolerations) > 0 {
+		o.RegisterItems(ItemDescriptor{
+			Width: component.WidthHalf,
+			Func: func() (component.Component, error) {
+				return printTolerations(corev1.PodSpec{Tolerations: scheduling.Tolerations})
+			},
+		})
+	}
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createRuntimeClassPodsView(ctx, runtimeClass, options)
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+func createRuntimeClassConfiguration(runtimeClass *nodev1beta1.RuntimeClass) *component.Summary {
+	sections := component.SummarySections{}
+
+	sections.AddText("Handler", runtimeClass.Handler)
+
+	overhead := "<none>"
+	if runtimeClass.Overhead != nil && len(runtimeClass.Overhead.PodFixed) > 0 {
+		overhead = formatResourceList(runtimeClass.Overhead.PodFixed)
+	}
+	sections.AddText("Overhead", overhead)
+
+	if scheduling := runtimeClass.Scheduling; scheduling != nil && len(scheduling.NodeSelector) > 0 {
+		sections.Add("Node Selector", component.NewLabels(scheduling.NodeSelector))
+	}
+
+	return component.NewSummary("Configuration", sections...)
+}
+
+// createRuntimeClassPodsView lists the pods in the cache which use a runtime class.
+func createRuntimeClassPodsView(ctx context.Context, runtimeClass *nodev1beta1.RuntimeClass, options Options) (*component.Table, error) {
+	table := component.NewTable("Pods", "No pods use this runtime class", runtimeClassPodsCols)
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	for i := range list.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		if pod.Spec.RuntimeClassName == nil || *pod.Spec.RuntimeClassName != runtimeClass.Name {
+			continue
+		}
+
+		var name component.Component = component.NewText(pod.Name)
+		if l, err := options.Link.ForObject(pod, pod.Name); err == nil {
+			name = l
+		}
+
+		table.Add(component.TableRow{
+			"Namespace": component.NewText(pod.Namespace),
+			"Name":      name,
+			"Node":      component.NewText(valueOrNone(pod.Spec.NodeName)),
+		})
+	}
+
+	return table, nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPodRuntimeClassView(t *testing.T) {
+	runtimeClass := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "node.k8s.io/v1",
+		"kind":       "RuntimeClass",
+		"metadata":   map[string]interface{}{"name": "kata"},
+		"handler":    "kata-qemu",
+	}}
+
+	key := store.Key{APIVersion: "node.k8s.io/v1", Kind: "RuntimeClass", Name: "kata"}
+
+	tests := []struct {
+		name             string
+		runtimeClassName *string
+		setup            func(tpo *testPrinterOptions)
+		expected         component.Component
+	}{
+		{
+			name:     "no runtime class",
+			expected: nil,
+		},
+		{
+			name:             "runtime class in cache",
+			runtimeClassName: pointer.StringPtr("kata"),
+			setup: func(tpo *testPrinterOptions) {
+				tpo.objectStore.EXPECT().Get(gomock.Any(), key).Return(runtimeClass, nil)
+				tpo.PathForGVK("", "node.k8s.io/v1", "RuntimeClass", "kata", "kata", "/kata")
+			},
+			expected: component.NewSummary("Runtime", component.SummarySections{
+				{Header: "Runtime Class", Content: component.NewLink("", "kata", "/kata")},
+				{Header: "Handler", Content: component.NewText("kata-qemu")},
+				{Header: "Overhead", Content: component.NewText("cpu=250m, memory=120Mi")},
+			}...),
+		},
+		{
+			name:             "runtime class not in cache",
+			runtimeClassName: pointer.StringPtr("kata"),
+			setup: func(tpo *testPrinterOptions) {
+				tpo.objectStore.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+			},
+			expected: component.NewSummary("Runtime", component.SummarySections{
+				{Header: "Runtime Class", Content: component.NewText("kata")},
+				{Header: "Overhead", Content: component.NewText("cpu=250m, memory=120Mi")},
+			}...),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			if test.setup != nil {
+				test.setup(tpo)
+			}
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.RuntimeClassName = test.runtimeClassName
+			pod.Spec.Overhead = corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("250m"),
+				corev1.ResourceMemory: resource.MustParse("120Mi"),
+			}
+
+			got, err := createPodRuntimeClassView(context.Background(), pod, tpo.ToOptions())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
+
+func Test_createRuntimeClassConfiguration(t *testing.T) {
+	tests := []struct {
+		name         string
+		runtimeClass *nodev1beta1.RuntimeClass
+		expected     component.SummarySections
+	}{
+		{
+			name:         "no overhead",
+			runtimeClass: &nodev1beta1.RuntimeClass{Handler: "runsc"},
+			expected: component.SummarySections{
+				{Header: "Handler", Content: component.NewText("runsc")},
+				{Header: "Overhead", Content: component.NewText("<none>")},
+			},
+		},
+		{
+			name: "overhead and scheduling",
+			runtimeClass: &nodev1beta1.RuntimeClass{
+				Handler: "kata-qemu",
+				Overhead: &nodev1beta1.Overhead{
+					PodFixed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+				},
+				Scheduling: &nodev1beta1.Scheduling{
+					NodeSelector: map[string]string{"sandbox": "kata"},
+				},
+			},
+			expected: component.SummarySections{
+				{Header: "Handler", Content: component.NewText("kata-qemu")},
+				{Header: "Overhead", Content: component.NewText("cpu=250m")},
+				{Header: "Node Selector", Content: component.NewLabels(map[string]string{"sandbox": "kata"})},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := createRuntimeClassConfiguration(test.runtimeClass)
+			component.AssertEqual(t, component.NewSummary("Configuration", test.expected...), got)
+		})
+	}
+}
+
+func Test_createRuntimeClassPodsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	runtimeClass := &nodev1beta1.RuntimeClass{}
+	runtimeClass.Name = "kata"
+
+	sandboxed := testutil.CreatePod("sandboxed")
+	sandboxed.Spec.RuntimeClassName = pointer.StringPtr("kata")
+	sandboxed.Spec.NodeName = "node"
+
+	other := testutil.CreatePod("other")
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, sandboxed, other), false, nil)
+	tpo.PathForObject(sandboxed, "sandboxed", "/sandboxed")
+
+	got, err := createRuntimeClassPodsView(context.Background(), runtimeClass, tpo.ToOptions())
+	require.NoError(t, err)
+
+	expected := component.NewTable("Pods", "No pods use this runtime class", runtimeClassPodsCols)
+	expected.Add(component.TableRow{
+		"Namespace": component.NewText("namespace"),
+		"Name":      component.NewLink("", "sandboxed", "/sandboxed"),
+		"Node":      component.NewText("node"),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
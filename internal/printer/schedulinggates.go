@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	podSchedulingGateCols = component.NewTableCols("Name")
+)
+
+// createPodSchedulingGatesView prints the scheduling gates which keep a pod from being
+// scheduled. Scheduling gates are not part of the core/v1 types, so they are read from the
+// cached pod. Pods without scheduling gates have no view.
+func createPodSchedulingGatesView(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	key := store.Key{
+		Namespace:  pod.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       pod.Name,
+	}
+
+	object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pod")
+	}
+
+	if object == nil {
+		return nil, nil
+	}
+
+	gates := podSchedulingGates(object)
+	if len(gates) == 0 {
+		return nil, nil
+	}
+
+	table := component.NewTable("Gates", "Pod has no scheduling gates", podSchedulingGateCols)
+	for _, gate := range gates {
+		table.Add(component.TableRow{
+			"Name": component.NewText(gate),
+		})
+	}
+
+	status := component.NewText("Scheduling gated")
+	status.SetStatus(component.TextStatusWarning)
+
+	summary := component.NewSummary("Scheduling Gates", component.SummarySections{
+		{Header: "Status", Content: status},
+		{Header: "Gates", Content: table},
+	}...)
+	summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+		"Pod won't be scheduled until all of its scheduling gates are removed"))
+
+	return summary, nil
+}
+
+// podSchedulingGates returns the names of the scheduling gates in a pod's spec.
+func podSchedulingGates(object *unstructured.Unstructured) []string {
+	entries, _, _ := unstructured.NestedSlice(object.Object, "spec", "schedulingGates")
+
+	var gates []string
+	for _, entry := range entries {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, _, _ := unstructured.NestedString(m, "name"); name != "" {
+			gates = append(gates, name)
+		}
+	}
+
+	return gates
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createPodSchedulingGatesView(t *testing.T) {
+	tests := []struct {
+		name     string
+		gates    []interface{}
+		notFound bool
+		expected func() component.Component
+	}{
+		{
+			name:     "pod not in cache",
+			notFound: true,
+		},
+		{
+			name: "no scheduling gates",
+		},
+		{
+			name: "scheduling gates",
+			gates: []interface{}{
+				map[string]interface{}{"name": "example.com/quota"},
+				map[string]interface{}{"name": "example.com/approval"},
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Gates", "Pod has no scheduling gates", podSchedulingGateCols)
+				table.Add(
+					component.TableRow{"Name": component.NewText("example.com/quota")},
+					component.TableRow{"Name": component.NewText("example.com/approval")},
+				)
+
+				status := component.NewText("Scheduling gated")
+				status.SetStatus(component.TextStatusWarning)
+
+				summary := component.NewSummary("Scheduling Gates", component.SummarySections{
+					{Header: "Status", Content: status},
+					{Header: "Gates", Content: table},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Pod won't be scheduled until all of its scheduling gates are removed"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			pod := testutil.CreatePod("pod")
+
+			var object *unstructured.Unstructured
+			if !test.notFound {
+				object = testutil.ToUnstructured(t, pod)
+				if test.gates != nil {
+					require.NoError(t, unstructured.SetNestedSlice(object.Object, test.gates, "spec", "schedulingGates"))
+				}
+			}
+
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+			tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+
+			got, err := createPodSchedulingGatesView(ctx, pod, tpo.ToOptions())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
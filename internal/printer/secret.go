@@ -7,6 +7,8 @@ package printer
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -27,6 +29,7 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 	}
 
 	ot := NewObjectTable("Secrets", "We couldn't find any secrets!", secretTableCols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, secret := range list.Items {
 		row := component.TableRow{}
@@ -37,7 +40,7 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(secret.ObjectMeta.Labels)
+		row["Labels"] = createLabelsView(&secret, secret.Labels, options)
 		row["Type"] = component.NewText(string(secret.Type))
 		row["Data"] = component.NewText(fmt.Sprintf("%d", len(secret.Data)))
 		row["Age"] = component.NewTimestamp(secret.ObjectMeta.CreationTimestamp.Time)
@@ -53,6 +56,7 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 // SecretHandler is a printFunc for printing a secret summary.
 func SecretHandler(ctx context.Context, secret *corev1.Secret, options Options) (component.Component, error) {
 	o := NewObject(secret)
+	o.EnableProblems()
 
 	sh, err := newSecretHandler(secret, o)
 	if err != nil {
@@ -96,10 +100,46 @@ func (s *SecretConfiguration) Create(options Options) (*component.Summary, error
 		Content: component.NewText(string(secret.Type)),
 	})
 
+	if secret.Type == corev1.SecretTypeTLS {
+		sections = append(sections, component.SummarySection{
+			Header:  "Certificate Expires",
+			Content: secretCertificateExpiry(secret.Data[corev1.TLSCertKey]),
+		})
+	}
+
 	summary := component.NewSummary("Configuration", sections...)
 	return summary, nil
 }
 
+// secretCertificateExpiry prints the time until the first certificate in a PEM bundle expires.
+func secretCertificateExpiry(data []byte) component.Component {
+	cert, err := parseCertificate(data)
+	if err == errNoCertificate {
+		text := component.NewText("Unable to find a certificate")
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	} else if err != nil {
+		text := component.NewTextf("Unable to parse certificate: %s", err)
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+
+	return component.NewCountdown(cert.NotAfter)
+}
+
+// errNoCertificate is returned by parseCertificate when data has no PEM encoded certificate.
+var errNoCertificate = errors.New("unable to find a certificate")
+
+// parseCertificate parses the first PEM encoded certificate in data.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errNoCertificate
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
 func describeSecretData(secret corev1.Secret) (*component.Table, error) {
 	table := component.NewTable("Data", "This secret has no data!", secretDataCols)
 
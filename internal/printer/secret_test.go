@@ -7,7 +7,15 @@ package printer
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -82,6 +90,20 @@ func Test_SecretConfig(t *testing.T) {
 	secret := testutil.CreateSecret("secret")
 	secret.Type = corev1.SecretTypeOpaque
 
+	notAfter := time.Unix(1600000000, 0)
+
+	tlsSecret := testutil.CreateSecret("tls")
+	tlsSecret.Type = corev1.SecretTypeTLS
+	tlsSecret.Data = map[string][]byte{
+		corev1.TLSCertKey: createTestCertificate(t, notAfter),
+	}
+
+	invalidTLSSecret := testutil.CreateSecret("invalid")
+	invalidTLSSecret.Type = corev1.SecretTypeTLS
+
+	invalidCertificate := component.NewText("Unable to find a certificate")
+	invalidCertificate.SetStatus(component.TextStatusWarning)
+
 	cases := []struct {
 		name     string
 		secret   *corev1.Secret
@@ -97,6 +119,32 @@ func Test_SecretConfig(t *testing.T) {
 					Content: component.NewText("Opaque"),
 				},
 			}...)},
+		{
+			name:   "tls",
+			secret: tlsSecret,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Type",
+					Content: component.NewText("kubernetes.io/tls"),
+				},
+				{
+					Header:  "Certificate Expires",
+					Content: component.NewCountdown(notAfter),
+				},
+			}...)},
+		{
+			name:   "tls without a certificate",
+			secret: invalidTLSSecret,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Type",
+					Content: component.NewText("kubernetes.io/tls"),
+				},
+				{
+					Header:  "Certificate Expires",
+					Content: invalidCertificate,
+				},
+			}...)},
 		{
 			name:   "secret is nil",
 			secret: nil,
@@ -124,6 +172,23 @@ func Test_SecretConfig(t *testing.T) {
 	}
 }
 
+func createTestCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
 func Test_describeSecretData(t *testing.T) {
 	secret := testutil.CreateSecret("secret")
 	secret.Data = map[string][]byte{
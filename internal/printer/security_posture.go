@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// securityPostureErrorPenalty is the score deducted for each serious security finding.
+	securityPostureErrorPenalty = 25
+	// securityPostureWarningPenalty is the score deducted for each other security finding.
+	securityPostureWarningPenalty = 10
+)
+
+var (
+	securityFindingCols = component.NewTableCols("Container", "Severity", "Finding")
+)
+
+// securityFinding is a security relevant setting in a pod spec. Findings which apply to
+// the whole pod have no container.
+type securityFinding struct {
+	container string
+	severity  component.TextStatus
+	message   string
+}
+
+// createSecurityPostureView summarizes the security relevant settings of a workload's pod
+// spec: privileged containers, containers which run or may run as root, hostPath mounts,
+// host namespaces, and containers without a security context. Each finding lowers the
+// workload's score from 100, and links the offending container, or the pod for findings
+// which apply to every container, to the view showing it: a pod's own view, or for a
+// workload, the view of a pod it selects with selector, falling back to the workload's pod
+// template. A hardened workload has a full score and no findings.
+func createSecurityPostureView(ctx context.Context, object runtime.Object, selector *metav1.LabelSelector, podSpec corev1.PodSpec, options Options) (*component.Summary, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	findings := detectSecurityFindings(podSpec)
+
+	score := 100
+	hasError := false
+	for _, finding := range findings {
+		if finding.severity == component.TextStatusError {
+			score -= securityPostureErrorPenalty
+			hasError = true
+		} else {
+			score -= securityPostureWarningPenalty
+		}
+	}
+	if score < 0 {
+		score = 0
+	}
+
+	scoreText := component.NewTextf("%d/100", score)
+
+	sections := component.SummarySections{
+		{Header: "Score", Content: scoreText},
+	}
+
+	if len(findings) == 0 {
+		scoreText.SetStatus(component.TextStatusOK)
+
+		clean := component.NewText("No issues found")
+		clean.SetStatus(component.TextStatusOK)
+		sections = append(sections, component.SummarySection{Header: "Findings", Content: clean})
+
+		return component.NewSummary("Security Posture", sections...), nil
+	}
+
+	target, err := securityFindingTarget(ctx, object, selector, options)
+	if err != nil {
+		return nil, err
+	}
+
+	table := component.NewTable("Findings", "There are no security findings!", securityFindingCols)
+	for _, finding := range findings {
+		name := "Pod"
+		if finding.container != "" {
+			name = finding.container
+		}
+
+		container, err := options.Link.ForObject(target, name)
+		if err != nil {
+			return nil, err
+		}
+
+		severity := component.NewText("Warning")
+		if finding.severity == component.TextStatusError {
+			severity = component.NewText("Error")
+		}
+		severity.SetStatus(finding.severity)
+
+		table.Add(component.TableRow{
+			"Container": container,
+			"Severity":  severity,
+			"Finding":   component.NewText(finding.message),
+		})
+	}
+	sections = append(sections, component.SummarySection{Header: "Findings", Content: table})
+
+	summary := component.NewSummary("Security Posture", sections...)
+
+	alertType := component.AlertTypeWarning
+	scoreText.SetStatus(component.TextStatusWarning)
+	if hasError {
+		alertType = component.AlertTypeError
+		scoreText.SetStatus(component.TextStatusError)
+	}
+	summary.SetAlert(component.NewAlert(alertType, fmt.Sprintf("%d security findings", len(findings))))
+
+	return summary, nil
+}
+
+// securityFindingTarget returns the object whose view shows the containers findings are
+// for. Pods show their own containers. Workloads link to the first pod selected by selector,
+// or to themselves, which show their pod template, if they have no pods.
+func securityFindingTarget(ctx context.Context, object runtime.Object, selector *metav1.LabelSelector, options Options) (runtime.Object, error) {
+	if _, ok := object.(*corev1.Pod); ok || selector == nil {
+		return object, nil
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, err
+	}
+
+	key := store.Key{
+		Namespace:  accessor.GetNamespace(),
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	pods, err := loadPods(ctx, key, options.DashConfig.ObjectStore(), selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "load pods")
+	}
+
+	if len(pods) == 0 {
+		return object, nil
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods[0], nil
+}
+
+// detectSecurityFindings finds the security relevant settings in a pod spec. Pod findings come
+// first, followed by findings for each container in the order the containers are declared.
+func detectSecurityFindings(podSpec corev1.PodSpec) []securityFinding {
+	var findings []securityFinding
+
+	addPod := func(message string) {
+		findings = append(findings, securityFinding{severity: component.TextStatusError, message: message})
+	}
+
+	if podSpec.HostNetwork {
+		addPod("Uses the host network namespace")
+	}
+	if podSpec.HostPID {
+		addPod("Uses the host PID namespace")
+	}
+	if podSpec.HostIPC {
+		addPod("Uses the host IPC namespace")
+	}
+
+	hostPaths := map[string]string{}
+	for _, volume := range podSpec.Volumes {
+		if volume.HostPath != nil {
+			hostPaths[volume.Name] = volume.HostPath.Path
+		}
+	}
+
+	podContext := podSpec.SecurityContext
+
+	containers := append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+	for _, c := range containers {
+		add := func(severity component.TextStatus, message string) {
+			findings = append(findings, securityFinding{container: c.Name, severity: severity, message: message})
+		}
+
+		containerContext := c.SecurityContext
+
+		if containerContext == nil && podContext == nil {
+			add(component.TextStatusWarning, "No security context is set")
+		}
+
+		if containerContext != nil && containerContext.Privileged != nil && *containerContext.Privileged {
+			add(component.TextStatusError, "Runs privileged")
+		}
+
+		runAsUser, runAsNonRoot := effectiveRunAs(podContext, containerContext)
+		switch {
+		case runAsUser != nil && *runAsUser == 0:
+			add(component.TextStatusError, "Runs as root (runAsUser 0)")
+		case runAsUser == nil && !runAsNonRoot:
+			add(component.TextStatusWarning, "May run as root (runAsNonRoot is not set)")
+		}
+
+		var mounts []string
+		for _, mount := range c.VolumeMounts {
+			if path, ok := hostPaths[mount.Name]; ok {
+				mounts = append(mounts, fmt.Sprintf("Mounts hostPath %s (volume %s)", path, mount.Name))
+			}
+		}
+		sort.Strings(mounts)
+		for _, mount := range mounts {
+			add(component.TextStatusError, mount)
+		}
+	}
+
+	return findings
+}
+
+// effectiveRunAs returns the user a container runs as and whether it must run as non-root.
+// Container settings take precedence over pod settings.
+func effectiveRunAs(podContext *corev1.PodSecurityContext, containerContext *corev1.SecurityContext) (*int64, bool) {
+	var runAsUser *int64
+	var runAsNonRoot *bool
+
+	if podContext != nil {
+		runAsUser = podContext.RunAsUser
+		runAsNonRoot = podContext.RunAsNonRoot
+	}
+
+	if containerContext != nil {
+		if containerContext.RunAsUser != nil {
+			runAsUser = containerContext.RunAsUser
+		}
+		if containerContext.RunAsNonRoot != nil {
+			runAsNonRoot = containerContext.RunAsNonRoot
+		}
+	}
+
+	return runAsUser, runAsNonRoot != nil && *runAsNonRoot
+}
@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createSecurityPostureView(t *testing.T) {
+	root := int64(0)
+	user := int64(1000)
+	yes := true
+
+	hardened := corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: &yes},
+		Containers: []corev1.Container{
+			{Name: "app", SecurityContext: &corev1.SecurityContext{RunAsUser: &user}},
+		},
+	}
+
+	insecure := corev1.PodSpec{
+		HostNetwork: true,
+		HostPID:     true,
+		Volumes: []corev1.Volume{
+			{Name: "docker", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/run/docker.sock"}}},
+			{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+		InitContainers: []corev1.Container{
+			{Name: "setup"},
+		},
+		Containers: []corev1.Container{
+			{
+				Name:            "app",
+				SecurityContext: &corev1.SecurityContext{Privileged: &yes, RunAsUser: &root},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "docker", MountPath: "/var/run/docker.sock"},
+					{Name: "data", MountPath: "/data"},
+				},
+			},
+		},
+	}
+
+	text := func(s string, status component.TextStatus) *component.Text {
+		t := component.NewText(s)
+		t.SetStatus(status)
+		return t
+	}
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	pod := testutil.CreatePod("pod")
+	pod.Labels = map[string]string{"app": "web"}
+
+	other := testutil.CreatePod("other")
+	other.Labels = map[string]string{"app": "db"}
+
+	insecureFindings := func(ref string) *component.Summary {
+		container := func(name string) *component.Link {
+			return component.NewLink("", name, ref)
+		}
+
+		table := component.NewTable("Findings", "There are no security findings!", securityFindingCols)
+		table.Add(
+			component.TableRow{
+				"Container": container("Pod"),
+				"Severity":  text("Error", component.TextStatusError),
+				"Finding":   component.NewText("Uses the host network namespace"),
+			},
+			component.TableRow{
+				"Container": container("Pod"),
+				"Severity":  text("Error", component.TextStatusError),
+				"Finding":   component.NewText("Uses the host PID namespace"),
+			},
+			component.TableRow{
+				"Container": container("setup"),
+				"Severity":  text("Warning", component.TextStatusWarning),
+				"Finding":   component.NewText("No security context is set"),
+			},
+			component.TableRow{
+				"Container": container("setup"),
+				"Severity":  text("Warning", component.TextStatusWarning),
+				"Finding":   component.NewText("May run as root (runAsNonRoot is not set)"),
+			},
+			component.TableRow{
+				"Container": container("app"),
+				"Severity":  text("Error", component.TextStatusError),
+				"Finding":   component.NewText("Runs privileged"),
+			},
+			component.TableRow{
+				"Container": container("app"),
+				"Severity":  text("Error", component.TextStatusError),
+				"Finding":   component.NewText("Runs as root (runAsUser 0)"),
+			},
+			component.TableRow{
+				"Container": container("app"),
+				"Severity":  text("Error", component.TextStatusError),
+				"Finding":   component.NewText("Mounts hostPath /var/run/docker.sock (volume docker)"),
+			},
+		)
+
+		summary := component.NewSummary("Security Posture", component.SummarySections{
+			{Header: "Score", Content: text("0/100", component.TextStatusError)},
+			{Header: "Findings", Content: table},
+		}...)
+		summary.SetAlert(component.NewAlert(component.AlertTypeError, "7 security findings"))
+		return summary
+	}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		selector *metav1.LabelSelector
+		podSpec  corev1.PodSpec
+		pods     []runtime.Object
+		target   runtime.Object
+		expected func() *component.Summary
+	}{
+		{
+			name:     "hardened workload",
+			object:   deployment,
+			selector: deployment.Spec.Selector,
+			podSpec:  hardened,
+			expected: func() *component.Summary {
+				return component.NewSummary("Security Posture", component.SummarySections{
+					{Header: "Score", Content: text("100/100", component.TextStatusOK)},
+					{Header: "Findings", Content: text("No issues found", component.TextStatusOK)},
+				}...)
+			},
+		},
+		{
+			name:    "insecure pod links to the pod",
+			object:  pod,
+			podSpec: insecure,
+			target:  pod,
+			expected: func() *component.Summary {
+				return insecureFindings("/pod")
+			},
+		},
+		{
+			name:     "insecure workload links to a selected pod",
+			object:   deployment,
+			selector: deployment.Spec.Selector,
+			podSpec:  insecure,
+			pods:     []runtime.Object{other, pod},
+			target:   pod,
+			expected: func() *component.Summary {
+				return insecureFindings("/pod")
+			},
+		},
+		{
+			name:     "insecure workload without pods links to the workload",
+			object:   deployment,
+			selector: deployment.Spec.Selector,
+			podSpec:  insecure,
+			pods:     []runtime.Object{other},
+			target:   deployment,
+			expected: func() *component.Summary {
+				return insecureFindings("/deployment")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			if test.selector != nil && test.target != nil {
+				key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+				tpo.objectStore.EXPECT().List(ctx, key).Return(testutil.ToUnstructuredList(t, test.pods...), false, nil)
+			}
+
+			if test.target != nil {
+				ref := "/" + test.target.(metav1.Object).GetName()
+				for _, name := range []string{"Pod", "setup", "app"} {
+					tpo.link.EXPECT().ForObject(gomock.Any(), name).
+						DoAndReturn(func(object runtime.Object, text string) (*component.Link, error) {
+							require.Equal(t, test.target.(metav1.Object).GetName(), object.(metav1.Object).GetName())
+							return component.NewLink("", text, ref), nil
+						}).AnyTimes()
+				}
+			}
+
+			got, err := createSecurityPostureView(ctx, test.object, test.selector, test.podSpec, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
+
+func Test_effectiveRunAs(t *testing.T) {
+	root := int64(0)
+	user := int64(1000)
+	yes := true
+	no := false
+
+	tests := []struct {
+		name             string
+		podContext       *corev1.PodSecurityContext
+		containerContext *corev1.SecurityContext
+		user             *int64
+		nonRoot          bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:       "pod settings",
+			podContext: &corev1.PodSecurityContext{RunAsUser: &user, RunAsNonRoot: &yes},
+			user:       &user,
+			nonRoot:    true,
+		},
+		{
+			name:             "container overrides pod",
+			podContext:       &corev1.PodSecurityContext{RunAsUser: &user, RunAsNonRoot: &yes},
+			containerContext: &corev1.SecurityContext{RunAsUser: &root, RunAsNonRoot: &no},
+			user:             &root,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			user, nonRoot := effectiveRunAs(test.podContext, test.containerContext)
+			require.Equal(t, test.user, user)
+			require.Equal(t, test.nonRoot, nonRoot)
+		})
+	}
+}
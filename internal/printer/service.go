@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -30,6 +31,7 @@ func ServiceListHandler(ctx context.Context, list *corev1.ServiceList, options O
 
 	cols := component.NewTableCols("Name", "Labels", "Type", "Cluster IP", "External IP", "Ports", "Age", "Selector")
 	ot := NewObjectTable("Services", "We couldn't find any services!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, s := range list.Items {
 		row := component.TableRow{}
@@ -39,7 +41,7 @@ func ServiceListHandler(ctx context.Context, list *corev1.ServiceList, options O
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(s.Labels)
+		row["Labels"] = createLabelsView(&s, s.Labels, options)
 		row["Type"] = component.NewText(string(s.Spec.Type))
 		row["Cluster IP"] = component.NewText(s.Spec.ClusterIP)
 		row["External IP"] = component.NewText(describeExternalIPs(s))
@@ -75,6 +77,10 @@ func ServiceHandler(ctx context.Context, service *corev1.Service, options Option
 		return nil, errors.Wrap(err, "print service status")
 	}
 
+	if err := sh.LoadBalancer(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print service load balancer")
+	}
+
 	if err := sh.Endpoints(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print service endpoints")
 	}
@@ -562,6 +568,20 @@ func defaultServiceStatus(service *corev1.Service) (*component.Summary, error) {
 	return createServiceSummaryStatus(service)
 }
 
+func (s *serviceHandler) LoadBalancer(ctx context.Context, options Options) error {
+	if s.service == nil {
+		return errors.New("can't display load balancer for nil service")
+	}
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createServiceLoadBalancerView(ctx, s.service, time.Now(), options)
+		},
+	})
+	return nil
+}
+
 func (s *serviceHandler) Endpoints(ctx context.Context, options Options) error {
 	if s.service == nil {
 		return errors.New("can't display endpoints for nil service")
@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// DefaultServiceReferencePattern matches service DNS names of the form
+	// <service>.<namespace>.svc, e.g. db.data.svc.cluster.local.
+	DefaultServiceReferencePattern = `\b(?P<service>[a-z0-9]([-a-z0-9]*[a-z0-9])?)\.(?P<namespace>[a-z0-9]([-a-z0-9]*[a-z0-9])?)\.svc\b`
+)
+
+var (
+	serviceReferenceCols = component.NewTableCols("Service", "Namespace", "Referenced By")
+)
+
+// serviceReference is a service in another namespace referenced by a workload's containers.
+type serviceReference struct {
+	namespace string
+	name      string
+	// sources are the container env vars which reference the service, as container/var.
+	sources []string
+}
+
+// serviceReferencePattern returns the pattern used to find service DNS names in container
+// env vars, and the indexes of its service and namespace named groups, which it must have.
+func (o Options) serviceReferencePattern() (*regexp.Regexp, int, int, error) {
+	pattern := o.ServiceReferencePattern
+	if pattern == "" {
+		pattern = DefaultServiceReferencePattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(err, "compile service reference pattern")
+	}
+
+	serviceIndex, namespaceIndex := -1, -1
+	for i, name := range re.SubexpNames() {
+		switch name {
+		case "service":
+			serviceIndex = i
+		case "namespace":
+			namespaceIndex = i
+		}
+	}
+	if serviceIndex < 0 || namespaceIndex < 0 {
+		return nil, 0, 0, errors.Errorf("service reference pattern %q needs service and namespace groups", pattern)
+	}
+
+	return re, serviceIndex, namespaceIndex, nil
+}
+
+// createServiceReferencesView prints the services in other namespaces which a workload's
+// containers reference by DNS name in their env vars. This is a best-effort view of
+// dependencies that selectors don't show. Services found in the cache are linked; others are
+// printed as text. If no other namespace's services are referenced, no view is returned.
+func createServiceReferencesView(ctx context.Context, namespace string, template corev1.PodTemplateSpec, options Options) (component.Component, error) {
+	re, serviceIndex, namespaceIndex, err := options.serviceReferencePattern()
+	if err != nil {
+		return nil, err
+	}
+
+	references := findServiceReferences(namespace, template, re, serviceIndex, namespaceIndex)
+	if len(references) == 0 {
+		return nil, nil
+	}
+
+	table := component.NewTable("Service References", "There are no service references!", serviceReferenceCols)
+
+	for _, reference := range references {
+		key := store.Key{
+			Namespace:  reference.namespace,
+			APIVersion: "v1",
+			Kind:       "Service",
+			Name:       reference.name,
+		}
+
+		object, err := options.DashConfig.ObjectStore().Get(ctx, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "get service")
+		}
+
+		var service component.Component = component.NewText(reference.name)
+		if object != nil {
+			service, err = options.Link.ForGVK(reference.namespace, "v1", "Service", reference.name, reference.name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		table.Add(component.TableRow{
+			"Service":       service,
+			"Namespace":     component.NewText(reference.namespace),
+			"Referenced By": component.NewText(strings.Join(reference.sources, ", ")),
+		})
+	}
+
+	return table, nil
+}
+
+// findServiceReferences finds the services outside namespace referenced by the env vars of
+// a pod template's containers, sorted by namespace and name. serviceIndex and namespaceIndex
+// are the indexes of re's service and namespace groups.
+func findServiceReferences(namespace string, template corev1.PodTemplateSpec, re *regexp.Regexp, serviceIndex, namespaceIndex int) []serviceReference {
+	byKey := map[string]*serviceReference{}
+
+	containers := append(append([]corev1.Container{}, template.Spec.InitContainers...), template.Spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			for _, match := range re.FindAllStringSubmatch(env.Value, -1) {
+				name, ns := match[serviceIndex], match[namespaceIndex]
+				if ns == namespace {
+					continue
+				}
+
+				key := ns + "/" + name
+				reference, ok := byKey[key]
+				if !ok {
+					reference = &serviceReference{namespace: ns, name: name}
+					byKey[key] = reference
+				}
+
+				source := fmt.Sprintf("%s/%s", c.Name, env.Name)
+				if len(reference.sources) == 0 || reference.sources[len(reference.sources)-1] != source {
+					reference.sources = append(reference.sources, source)
+				}
+			}
+		}
+	}
+
+	var references []serviceReference
+	for _, reference := range byKey {
+		references = append(references, *reference)
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].namespace != references[j].namespace {
+			return references[i].namespace < references[j].namespace
+		}
+		return references[i].name < references[j].name
+	})
+
+	return references
+}
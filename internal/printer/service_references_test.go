@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createServiceReferencesView(t *testing.T) {
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Name: "migrate",
+					Env:  []corev1.EnvVar{{Name: "DB_HOST", Value: "db.data.svc.cluster.local"}},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					Env: []corev1.EnvVar{
+						{Name: "DB_URL", Value: "postgres://db.data.svc:5432/app"},
+						{Name: "CACHE", Value: "redis://cache.namespace.svc:6379"},
+						{Name: "PEERS", Value: "auth.identity.svc,queue.messaging.svc.cluster.local"},
+						{Name: "NAME", Value: "app"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template corev1.PodTemplateSpec
+		pattern  string
+		cached   map[string]bool
+		expected func() component.Component
+		isErr    bool
+	}{
+		{
+			name: "no service references",
+			template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Env: []corev1.EnvVar{{Name: "NAME", Value: "app"}}}}},
+			},
+		},
+		{
+			name:     "cross-namespace references",
+			template: template,
+			cached:   map[string]bool{"data/db": true, "messaging/queue": true},
+			expected: func() component.Component {
+				table := component.NewTable("Service References", "There are no service references!", serviceReferenceCols)
+				table.Add(
+					component.TableRow{
+						"Service":       component.NewLink("", "db", "/db"),
+						"Namespace":     component.NewText("data"),
+						"Referenced By": component.NewText("migrate/DB_HOST, app/DB_URL"),
+					},
+					component.TableRow{
+						"Service":       component.NewText("auth"),
+						"Namespace":     component.NewText("identity"),
+						"Referenced By": component.NewText("app/PEERS"),
+					},
+					component.TableRow{
+						"Service":       component.NewLink("", "queue", "/queue"),
+						"Namespace":     component.NewText("messaging"),
+						"Referenced By": component.NewText("app/PEERS"),
+					},
+				)
+				return table
+			},
+		},
+		{
+			name:     "custom pattern",
+			template: template,
+			pattern:  `(?P<service>[a-z]+)\.(?P<namespace>[a-z]+)\.svc\.cluster\.local`,
+			expected: func() component.Component {
+				table := component.NewTable("Service References", "There are no service references!", serviceReferenceCols)
+				table.Add(
+					component.TableRow{
+						"Service":       component.NewText("db"),
+						"Namespace":     component.NewText("data"),
+						"Referenced By": component.NewText("migrate/DB_HOST"),
+					},
+					component.TableRow{
+						"Service":       component.NewText("queue"),
+						"Namespace":     component.NewText("messaging"),
+						"Referenced By": component.NewText("app/PEERS"),
+					},
+				)
+				return table
+			},
+		},
+		{
+			name:     "pattern without groups",
+			template: template,
+			pattern:  `[a-z]+\.svc`,
+			isErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			if test.expected != nil {
+				tpo.objectStore.EXPECT().Get(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+						if !test.cached[key.Namespace+"/"+key.Name] {
+							return nil, nil
+						}
+						service := testutil.CreateService(key.Name)
+						service.Namespace = key.Namespace
+						return testutil.ToUnstructured(t, service), nil
+					}).AnyTimes()
+
+				for key := range test.cached {
+					parts := strings.SplitN(key, "/", 2)
+					tpo.PathForGVK(parts[0], "v1", "Service", parts[1], parts[1], "/"+parts[1])
+				}
+			}
+
+			options := tpo.ToOptions()
+			options.ServiceReferencePattern = test.pattern
+
+			got, err := createServiceReferencesView(ctx, "namespace", test.template, options)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
@@ -29,6 +29,7 @@ func ServiceAccountListHandler(ctx context.Context, list *corev1.ServiceAccountL
 	cols := component.NewTableCols("Name", "Labels", "Secrets", "Age")
 	ot := NewObjectTable("Service Accounts",
 		"We couldn't find any service accounts!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, serviceAccount := range list.Items {
 		row := component.TableRow{}
@@ -38,7 +39,7 @@ func ServiceAccountListHandler(ctx context.Context, list *corev1.ServiceAccountL
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(serviceAccount.Labels)
+		row["Labels"] = createLabelsView(&serviceAccount, serviceAccount.Labels, options)
 		row["Secrets"] = component.NewText(fmt.Sprint(len(serviceAccount.Secrets)))
 		row["Age"] = component.NewTimestamp(serviceAccount.CreationTimestamp.Time)
 
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -27,6 +28,8 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 
 	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Age", "Selector")
 	ot := NewObjectTable("StatefulSets", "We couldn't find any stateful sets!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
+	ot.SetStatusThresholds(options)
 
 	for _, statefulSet := range list.Items {
 		row := component.TableRow{}
@@ -36,13 +39,14 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(statefulSet.Labels)
+		row["Labels"] = createLabelsView(&statefulSet, statefulSet.Labels, options)
 
 		desired := fmt.Sprintf("%d", *statefulSet.Spec.Replicas)
 		row["Desired"] = component.NewText(desired)
 
-		current := fmt.Sprintf("%d", statefulSet.Status.Replicas)
-		row["Current"] = component.NewText(current)
+		current := component.NewTextf("%d", statefulSet.Status.Replicas)
+		setReplicaStatus(current, options, gvk.StatefulSet, int(statefulSet.Status.Replicas), int(replicasOrDefault(statefulSet.Spec.Replicas)))
+		row["Current"] = current
 
 		ts := statefulSet.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
@@ -61,6 +65,7 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 func StatefulSetHandler(ctx context.Context, statefulSet *appsv1.StatefulSet, options Options) (component.Component, error) {
 	o := NewObject(statefulSet)
 	o.EnableEvents()
+	o.EnableProblems()
 
 	sh, err := newStatufulSetHandler(statefulSet, o)
 	if err != nil {
@@ -102,7 +107,7 @@ func (sc *StatefulSetConfiguration) Create(options Options) (*component.Summary,
 
 	statefulSet := sc.statefulset
 
-	sections := component.SummarySections{}
+	sections := component.SummarySections(createMetadataSection(statefulSet, options).Config.Sections)
 
 	sections.AddText("Update Strategy", string(statefulSet.Spec.UpdateStrategy.Type))
 
@@ -139,6 +144,7 @@ func (sc *StatefulSetConfiguration) Create(options Options) (*component.Summary,
 	}
 
 	sections.AddText("Pod Management Policy", string(statefulSet.Spec.PodManagementPolicy))
+	sections.AddText("Pod Template Hash", podTemplateHash(statefulSet.Spec.Template))
 
 	summary := component.NewSummary("Configuration", sections...)
 	return summary, nil
@@ -252,12 +258,40 @@ func (s *statefulSetHandler) Status(ctx context.Context, options Options) error
 		return errors.New("can't display status for nil statefulset")
 	}
 
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthQuarter,
+		Func: func() (component.Component, error) {
+			return createWorkloadIndicators(ctx, s.statefulSet, s.statefulSet.Spec.Selector, replicasOrDefault(s.statefulSet.Spec.Replicas), options)
+		},
+	})
+
 	s.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthQuarter,
 		Func: func() (component.Component, error) {
 			return s.statusFunc(ctx, s.statefulSet, options)
 		},
 	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createPodAdoptionView(ctx, s.statefulSet, s.statefulSet.Spec.Selector, options)
+		},
+	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createStatefulSetPVCRetentionView(ctx, s.statefulSet, options)
+		},
+	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createRevisionHistoryView(ctx, s.statefulSet, s.statefulSet.Status.CurrentRevision, s.statefulSet.Status.UpdateRevision, options)
+		},
+	})
 	return nil
 }
 
@@ -268,6 +302,41 @@ func defaultStatefulSetStatus(ctx context.Context, statefulSet *appsv1.StatefulS
 func (s *statefulSetHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
 	s.object.EnablePodTemplate(s.statefulSet.Spec.Template)
 
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createConfigDriftView(ctx, s.statefulSet.Namespace, s.statefulSet.Spec.Template, options)
+		},
+	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createImageRegistryView(s.statefulSet.Spec.Template, options)
+		},
+	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createServiceReferencesView(ctx, s.statefulSet.Namespace, s.statefulSet.Spec.Template, options)
+		},
+	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createSecurityPostureView(ctx, s.statefulSet, s.statefulSet.Spec.Selector, s.statefulSet.Spec.Template.Spec, options)
+		},
+	})
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createReplicaSpreadView(ctx, s.statefulSet.Namespace, s.statefulSet.Spec.Selector, s.statefulSet.Spec.Template, options)
+		},
+	})
+
 	s.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
 		Func: func() (component.Component, error) {
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	pvcRetentionPolicyRetain = "Retain"
+	pvcRetentionPolicyDelete = "Delete"
+)
+
+var (
+	statefulSetPVCCols = component.NewTableCols("Name", "Template", "Ordinal", "Status")
+)
+
+// createStatefulSetPVCRetentionView prints what happens to the PersistentVolumeClaims created
+// from a StatefulSet's volume claim templates when the StatefulSet is deleted or scaled down,
+// and links the claims. Policies which delete claims are flagged as data loss risks. The
+// retention policy is not part of the apps/v1 types, so it is read from the cached
+// StatefulSet. StatefulSets without volume claim templates have no view.
+func createStatefulSetPVCRetentionView(ctx context.Context, statefulSet *appsv1.StatefulSet, options Options) (component.Component, error) {
+	if statefulSet == nil {
+		return nil, errors.New("statefulset is nil")
+	}
+
+	if len(statefulSet.Spec.VolumeClaimTemplates) == 0 {
+		return nil, nil
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	key := store.Key{
+		Namespace:  statefulSet.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "StatefulSet",
+		Name:       statefulSet.Name,
+	}
+
+	object, err := objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get statefulset")
+	}
+
+	if object == nil {
+		return nil, nil
+	}
+
+	var sections component.SummarySections
+	var risks []string
+
+	for _, policy := range []struct {
+		header string
+		field  string
+		risk   string
+	}{
+		{header: "When Deleted", field: "whenDeleted", risk: "deleting the StatefulSet"},
+		{header: "When Scaled", field: "whenScaled", risk: "scaling down the StatefulSet"},
+	} {
+		value, _, _ := unstructured.NestedString(object.Object, "spec", "persistentVolumeClaimRetentionPolicy", policy.field)
+
+		var text *component.Text
+		switch value {
+		case "":
+			text = component.NewText(fmt.Sprintf("%s (default)", pvcRetentionPolicyRetain))
+		case pvcRetentionPolicyDelete:
+			text = component.NewText(value)
+			text.SetStatus(component.TextStatusWarning)
+			risks = append(risks, policy.risk)
+		default:
+			text = component.NewText(value)
+		}
+
+		sections.Add(policy.header, text)
+	}
+
+	claims, err := statefulSetPVCs(ctx, statefulSet, options)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Claims", claims)
+
+	summary := component.NewSummary("PVC Retention", sections...)
+
+	if len(risks) > 0 {
+		summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Data loss risk: PersistentVolumeClaims are deleted when %s", strings.Join(risks, " or "))))
+	}
+
+	return summary, nil
+}
+
+// statefulSetPVCs prints the PersistentVolumeClaims created from a StatefulSet's volume claim
+// templates. Claims are named <template>-<statefulset>-<ordinal>, and claims retained from
+// replicas which have been scaled down are included.
+func statefulSetPVCs(ctx context.Context, statefulSet *appsv1.StatefulSet, options Options) (*component.Table, error) {
+	key := store.Key{
+		Namespace:  statefulSet.Namespace,
+		APIVersion: "v1",
+		Kind:       "PersistentVolumeClaim",
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list persistent volume claims")
+	}
+
+	type claim struct {
+		name     string
+		template string
+		ordinal  int
+		phase    string
+	}
+
+	var claims []claim
+	for i := range list.Items {
+		name := list.Items[i].GetName()
+
+		for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+			prefix := fmt.Sprintf("%s-%s-", template.Name, statefulSet.Name)
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+
+			ordinal, err := strconv.Atoi(strings.TrimPrefix(name, prefix))
+			if err != nil || ordinal < 0 {
+				continue
+			}
+
+			phase, _, _ := unstructured.NestedString(list.Items[i].Object, "status", "phase")
+			claims = append(claims, claim{name: name, template: template.Name, ordinal: ordinal, phase: phase})
+			break
+		}
+	}
+
+	sort.Slice(claims, func(i, j int) bool {
+		if claims[i].template != claims[j].template {
+			return claims[i].template < claims[j].template
+		}
+		return claims[i].ordinal < claims[j].ordinal
+	})
+
+	table := component.NewTable("Persistent Volume Claims", "There are no persistent volume claims!", statefulSetPVCCols)
+
+	for _, c := range claims {
+		nameLink, err := options.Link.ForGVK(statefulSet.Namespace, "v1", "PersistentVolumeClaim", c.name, c.name)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":     nameLink,
+			"Template": component.NewText(c.template),
+			"Ordinal":  component.NewText(strconv.Itoa(c.ordinal)),
+			"Status":   component.NewText(c.phase),
+		})
+	}
+
+	return table, nil
+}
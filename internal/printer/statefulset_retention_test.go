@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createStatefulSetPVCRetentionView(t *testing.T) {
+	claimsTable := func() *component.Table {
+		table := component.NewTable("Persistent Volume Claims", "There are no persistent volume claims!", statefulSetPVCCols)
+		table.Add(
+			component.TableRow{
+				"Name":     component.NewLink("", "data-web-0", "/data-web-0"),
+				"Template": component.NewText("data"),
+				"Ordinal":  component.NewText("0"),
+				"Status":   component.NewText("Bound"),
+			},
+			component.TableRow{
+				"Name":     component.NewLink("", "data-web-2", "/data-web-2"),
+				"Template": component.NewText("data"),
+				"Ordinal":  component.NewText("2"),
+				"Status":   component.NewText("Bound"),
+			},
+		)
+		return table
+	}
+
+	tests := []struct {
+		name      string
+		templates bool
+		notFound  bool
+		policy    map[string]interface{}
+		expected  func() component.Component
+	}{
+		{
+			name: "no volume claim templates",
+		},
+		{
+			name:      "statefulset not in cache",
+			templates: true,
+			notFound:  true,
+		},
+		{
+			name:      "default policy",
+			templates: true,
+			expected: func() component.Component {
+				return component.NewSummary("PVC Retention", component.SummarySections{
+					{Header: "When Deleted", Content: component.NewText("Retain (default)")},
+					{Header: "When Scaled", Content: component.NewText("Retain (default)")},
+					{Header: "Claims", Content: claimsTable()},
+				}...)
+			},
+		},
+		{
+			name:      "delete policy",
+			templates: true,
+			policy: map[string]interface{}{
+				"whenDeleted": "Delete",
+				"whenScaled":  "Retain",
+			},
+			expected: func() component.Component {
+				whenDeleted := component.NewText("Delete")
+				whenDeleted.SetStatus(component.TextStatusWarning)
+
+				summary := component.NewSummary("PVC Retention", component.SummarySections{
+					{Header: "When Deleted", Content: whenDeleted},
+					{Header: "When Scaled", Content: component.NewText("Retain")},
+					{Header: "Claims", Content: claimsTable()},
+				}...)
+				summary.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					"Data loss risk: PersistentVolumeClaims are deleted when deleting the StatefulSet"))
+				return summary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+			tpo.PathForGVK("namespace", "v1", "PersistentVolumeClaim", "data-web-0", "data-web-0", "/data-web-0")
+			tpo.PathForGVK("namespace", "v1", "PersistentVolumeClaim", "data-web-2", "data-web-2", "/data-web-2")
+
+			statefulSet := testutil.CreateStatefulSet("web")
+			if test.templates {
+				statefulSet.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				}
+
+				var object *unstructured.Unstructured
+				if !test.notFound {
+					object = testutil.ToUnstructured(t, statefulSet)
+					if test.policy != nil {
+						require.NoError(t, unstructured.SetNestedMap(object.Object, test.policy,
+							"spec", "persistentVolumeClaimRetentionPolicy"))
+					}
+
+					pvcKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim"}
+					tpo.objectStore.EXPECT().List(ctx, pvcKey).Return(testutil.ToUnstructuredList(t,
+						testutil.CreatePersistentVolumeClaim("data-web-2"),
+						testutil.CreatePersistentVolumeClaim("data-web-0"),
+						testutil.CreatePersistentVolumeClaim("data-other-0"),
+						testutil.CreatePersistentVolumeClaim("data-web-logs"),
+					), false, nil)
+				}
+
+				key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web"}
+				tpo.objectStore.EXPECT().Get(ctx, key).Return(object, nil)
+			}
+
+			got, err := createStatefulSetPVCRetentionView(ctx, statefulSet, tpo.ToOptions())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
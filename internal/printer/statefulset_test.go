@@ -220,6 +220,13 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 			name:        "default",
 			statefulSet: validStatefulSet,
 			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{Header: "Name", Content: component.NewText("web")},
+				{Header: "Namespace", Content: component.NewText("<none>")},
+				{Header: "UID", Content: component.NewText("<none>")},
+				{Header: "Created", Content: component.NewTimestamp(now)},
+				{Header: "Generation", Content: component.NewText("<none>")},
+				{Header: "Labels", Content: component.NewLabels(validStatefulSet.Labels)},
+				{Header: "Annotations", Content: component.NewText("<none>")},
 				{
 					Header:  "Update Strategy",
 					Content: component.NewText("RollingUpdate"),
@@ -236,6 +243,10 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 					Header:  "Pod Management Policy",
 					Content: component.NewText("OrderedReady"),
 				},
+				{
+					Header:  "Pod Template Hash",
+					Content: component.NewText(podTemplateHash(validStatefulSet.Spec.Template)),
+				},
 			}...),
 		},
 		{
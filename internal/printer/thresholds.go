@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	// DefaultStatusThreshold flags objects with fewer than their desired replicas ready as
+	// warnings. It has no error tier.
+	DefaultStatusThreshold = ThresholdConfig{WarningBelow: 100}
+)
+
+// ThresholdConfig configures the ready percentages at which a kind's status is
+// shown as a warning or an error.
+type ThresholdConfig struct {
+	// WarningBelow is the ready percentage below which the status is a warning.
+	WarningBelow int
+	// ErrorBelow is the ready percentage below which the status is an error.
+	ErrorBelow int
+}
+
+// Validate validates the threshold config. Percentages must be between 0 and 100,
+// and the error threshold can't be above the warning threshold.
+func (c ThresholdConfig) Validate() error {
+	if c.WarningBelow < 0 || c.WarningBelow > 100 {
+		return errors.Errorf("warning threshold %d is not a percentage", c.WarningBelow)
+	}
+
+	if c.ErrorBelow < 0 || c.ErrorBelow > 100 {
+		return errors.Errorf("error threshold %d is not a percentage", c.ErrorBelow)
+	}
+
+	if c.ErrorBelow > c.WarningBelow {
+		return errors.Errorf("error threshold %d is above warning threshold %d", c.ErrorBelow, c.WarningBelow)
+	}
+
+	return nil
+}
+
+// Status returns the status for a number of ready replicas out of the desired replicas.
+func (c ThresholdConfig) Status(ready, desired int) component.TextStatus {
+	if desired == 0 {
+		return component.TextStatusOK
+	}
+
+	percent := ready * 100 / desired
+
+	switch {
+	case percent < c.ErrorBelow:
+		return component.TextStatusError
+	case percent < c.WarningBelow:
+		return component.TextStatusWarning
+	default:
+		return component.TextStatusOK
+	}
+}
+
+// SetStatusThreshold validates a threshold config and sets it as the status
+// threshold for a group/version/kind.
+func (o *Options) SetStatusThreshold(groupVersionKind schema.GroupVersionKind, config ThresholdConfig) error {
+	if groupVersionKind.Kind == "" || groupVersionKind.Version == "" {
+		return errors.Errorf("invalid status threshold group/version/kind %q", groupVersionKind)
+	}
+
+	if err := config.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid status threshold for %s", groupVersionKind)
+	}
+
+	if o.StatusThresholds == nil {
+		o.StatusThresholds = make(map[schema.GroupVersionKind]ThresholdConfig)
+	}
+
+	o.StatusThresholds[groupVersionKind] = config
+
+	return nil
+}
+
+// configuredStatusThreshold returns the valid status threshold set for a group/version/kind.
+func (o Options) configuredStatusThreshold(groupVersionKind schema.GroupVersionKind) (ThresholdConfig, bool) {
+	config, ok := o.StatusThresholds[groupVersionKind]
+	if !ok || config.Validate() != nil {
+		return ThresholdConfig{}, false
+	}
+	return config, true
+}
+
+// statusThreshold returns the status threshold for a group/version/kind. If no threshold
+// is set, or the threshold set is invalid, DefaultStatusThreshold is returned.
+func (o Options) statusThreshold(groupVersionKind schema.GroupVersionKind) ThresholdConfig {
+	if config, ok := o.configuredStatusThreshold(groupVersionKind); ok {
+		return config
+	}
+	return DefaultStatusThreshold
+}
+
+// availabilityThreshold returns the status threshold for a workload's availability. If no
+// threshold is set for the group/version/kind, availability is an error below
+// AvailabilityThreshold and a warning below 100%.
+func (o Options) availabilityThreshold(groupVersionKind schema.GroupVersionKind) ThresholdConfig {
+	if config, ok := o.configuredStatusThreshold(groupVersionKind); ok {
+		return config
+	}
+
+	errorBelow := o.AvailabilityThreshold
+	if errorBelow == 0 {
+		errorBelow = DefaultAvailabilityThreshold
+	}
+
+	return ThresholdConfig{
+		WarningBelow: 100,
+		ErrorBelow:   errorBelow,
+	}
+}
+
+// setReplicaStatus flags text with the status for ready out of desired replicas using the
+// threshold set for a group/version/kind. Replica counts are only flagged when a threshold
+// is set, and text for replicas which meet it is left unflagged.
+func setReplicaStatus(text *component.Text, options Options, groupVersionKind schema.GroupVersionKind, ready, desired int) {
+	threshold, ok := options.configuredStatusThreshold(groupVersionKind)
+	if !ok {
+		return
+	}
+
+	if status := threshold.Status(ready, desired); status != component.TextStatusOK {
+		text.SetStatus(status)
+	}
+}
+
+// readyReplicas returns the ready and desired replicas of a workload.
+func readyReplicas(object runtime.Object) (int, int, bool) {
+	switch o := object.(type) {
+	case *appsv1.Deployment:
+		return int(o.Status.AvailableReplicas), int(replicasOrDefault(o.Spec.Replicas)), true
+	case *appsv1.ReplicaSet:
+		return int(o.Status.AvailableReplicas), int(replicasOrDefault(o.Spec.Replicas)), true
+	case *appsv1.StatefulSet:
+		return int(o.Status.ReadyReplicas), int(replicasOrDefault(o.Spec.Replicas)), true
+	case *appsv1.DaemonSet:
+		return int(o.Status.NumberReady), int(o.Status.DesiredNumberScheduled), true
+	case *corev1.ReplicationController:
+		return int(o.Status.AvailableReplicas), int(replicasOrDefault(o.Spec.Replicas)), true
+	default:
+		return 0, 0, false
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestOptions_SetStatusThreshold(t *testing.T) {
+	deploymentGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	tests := []struct {
+		name             string
+		groupVersionKind schema.GroupVersionKind
+		config           ThresholdConfig
+		isErr            bool
+	}{
+		{
+			name:             "valid threshold",
+			groupVersionKind: deploymentGVK,
+			config:           ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+		},
+		{
+			name:             "missing kind",
+			groupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1"},
+			config:           ThresholdConfig{WarningBelow: 80, ErrorBelow: 50},
+			isErr:            true,
+		},
+		{
+			name:             "warning threshold is not a percentage",
+			groupVersionKind: deploymentGVK,
+			config:           ThresholdConfig{WarningBelow: 120},
+			isErr:            true,
+		},
+		{
+			name:             "error threshold is negative",
+			groupVersionKind: deploymentGVK,
+			config:           ThresholdConfig{WarningBelow: 80, ErrorBelow: -1},
+			isErr:            true,
+		},
+		{
+			name:             "error threshold above warning threshold",
+			groupVersionKind: deploymentGVK,
+			config:           ThresholdConfig{WarningBelow: 50, ErrorBelow: 80},
+			isErr:            true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := Options{}
+
+			err := options.SetStatusThreshold(test.groupVersionKind, test.config)
+			if test.isErr {
+				require.Error(t, err)
+				assert.Empty(t, options.StatusThresholds)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.config, options.statusThreshold(test.groupVersionKind))
+		})
+	}
+}
+
+func TestOptions_statusThreshold_defaults(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+
+	assert.Equal(t, DefaultStatusThreshold, Options{}.statusThreshold(gvk))
+	assert.Equal(t, DefaultStatusThreshold, Options{AvailabilityThreshold: 60}.statusThreshold(gvk))
+}
+
+func TestOptions_availabilityThreshold_defaults(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+
+	assert.Equal(t, ThresholdConfig{WarningBelow: 100, ErrorBelow: DefaultAvailabilityThreshold},
+		Options{}.availabilityThreshold(gvk))
+	assert.Equal(t, ThresholdConfig{WarningBelow: 100, ErrorBelow: 60},
+		Options{AvailabilityThreshold: 60}.availabilityThreshold(gvk))
+}
+
+func Test_setReplicaStatus(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+
+	text := component.NewText("1/3")
+	setReplicaStatus(text, Options{}, gvk, 1, 3)
+	assert.Equal(t, component.NewText("1/3"), text)
+
+	options := Options{}
+	require.NoError(t, options.SetStatusThreshold(gvk, ThresholdConfig{WarningBelow: 100, ErrorBelow: 50}))
+
+	setReplicaStatus(text, options, gvk, 1, 3)
+	assert.Equal(t, component.TextStatusError, text.Config.Status)
+}
+
+func TestThresholdConfig_Status(t *testing.T) {
+	config := ThresholdConfig{WarningBelow: 80, ErrorBelow: 50}
+
+	tests := []struct {
+		ready    int
+		desired  int
+		expected component.TextStatus
+	}{
+		{ready: 10, desired: 10, expected: component.TextStatusOK},
+		{ready: 8, desired: 10, expected: component.TextStatusOK},
+		{ready: 7, desired: 10, expected: component.TextStatusWarning},
+		{ready: 5, desired: 10, expected: component.TextStatusWarning},
+		{ready: 4, desired: 10, expected: component.TextStatusError},
+		{ready: 0, desired: 0, expected: component.TextStatusOK},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, config.Status(test.ready, test.desired), "%d/%d", test.ready, test.desired)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	topologySpreadCols = component.NewTableCols("Topology Key", "Domain", "Pods", "Skew", "Max Skew", "When Unsatisfiable")
+)
+
+// createTopologySpreadView prints the observed distribution of pods across the topology
+// domains of each of a pod spec's topology spread constraints. Domains are the values of
+// the topology key on nodes in the cache. If the spec has no constraints, no view is returned.
+func createTopologySpreadView(ctx context.Context, namespace string, podSpec corev1.PodSpec, options Options) (component.Component, error) {
+	constraints := podSpec.TopologySpreadConstraints
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	nodeList, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list nodes")
+	}
+
+	nodeLabels := make(map[string]map[string]string)
+	for i := range nodeList.Items {
+		nodeLabels[nodeList.Items[i].GetName()] = nodeList.Items[i].GetLabels()
+	}
+
+	podList, _, err := objectStore.List(ctx, store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	var pods []*corev1.Pod
+	for i := range podList.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&podList.Items[i], pod); err != nil {
+			return nil, err
+		}
+		pods = append(pods, pod)
+	}
+
+	table := component.NewTable("Topology Spread", "There are no topology domains!", topologySpreadCols)
+
+	for _, constraint := range constraints {
+		counts, err := topologySpreadCounts(constraint, pods, nodeLabels)
+		if err != nil {
+			return nil, err
+		}
+
+		minCount := -1
+		for _, count := range counts {
+			if minCount < 0 || count < minCount {
+				minCount = count
+			}
+		}
+
+		var domains []string
+		for domain := range counts {
+			domains = append(domains, domain)
+		}
+		sort.Strings(domains)
+
+		for _, domain := range domains {
+			skew := counts[domain] - minCount
+
+			skewText := component.NewTextf("%d", skew)
+			if skew > int(constraint.MaxSkew) {
+				skewText.SetStatus(component.TextStatusWarning)
+			}
+
+			table.Add(component.TableRow{
+				"Topology Key":       component.NewText(constraint.TopologyKey),
+				"Domain":             component.NewText(domain),
+				"Pods":               component.NewTextf("%d", counts[domain]),
+				"Skew":               skewText,
+				"Max Skew":           component.NewTextf("%d", constraint.MaxSkew),
+				"When Unsatisfiable": component.NewText(string(constraint.WhenUnsatisfiable)),
+			})
+		}
+	}
+
+	return table, nil
+}
+
+// topologySpreadCounts counts the pods matching a constraint's selector in each topology domain.
+// Every domain known from the nodes is included, so domains without matching pods have a count of zero.
+func topologySpreadCounts(constraint corev1.TopologySpreadConstraint, pods []*corev1.Pod, nodeLabels map[string]map[string]string) (map[string]int, error) {
+	counts := make(map[string]int)
+
+	for _, labels := range nodeLabels {
+		if domain, ok := labels[constraint.TopologyKey]; ok {
+			counts[domain] = 0
+		}
+	}
+
+	selector := kLabels.Nothing()
+	if constraint.LabelSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(constraint.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("convert topology spread selector: %w", err)
+		}
+	}
+
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || !selector.Matches(kLabels.Set(pod.Labels)) {
+			continue
+		}
+
+		domain, ok := nodeLabels[pod.Spec.NodeName][constraint.TopologyKey]
+		if !ok {
+			continue
+		}
+
+		counts[domain]++
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createTopologySpreadView(t *testing.T) {
+	zoneNode := func(name, zone string) *corev1.Node {
+		node := testutil.CreateNode(name)
+		node.Labels = map[string]string{"zone": zone}
+		return node
+	}
+
+	scheduledPod := func(name, nodeName string, labels map[string]string) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Labels = labels
+		pod.Spec.NodeName = nodeName
+		return pod
+	}
+
+	constraint := corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       "zone",
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "web"},
+		},
+	}
+
+	nodes := []runtime.Object{
+		zoneNode("node-a", "us-east-1a"),
+		zoneNode("node-b", "us-east-1b"),
+		testutil.CreateNode("node-c"),
+	}
+
+	row := func(domain string, pods, skew int, skewStatus component.TextStatus) component.TableRow {
+		skewText := component.NewTextf("%d", skew)
+		if skewStatus != 0 {
+			skewText.SetStatus(skewStatus)
+		}
+
+		return component.TableRow{
+			"Topology Key":       component.NewText("zone"),
+			"Domain":             component.NewText(domain),
+			"Pods":               component.NewTextf("%d", pods),
+			"Skew":               skewText,
+			"Max Skew":           component.NewText("1"),
+			"When Unsatisfiable": component.NewText("DoNotSchedule"),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		constraints []corev1.TopologySpreadConstraint
+		pods        []runtime.Object
+		expected    func() component.Component
+	}{
+		{
+			name: "no constraints",
+			expected: func() component.Component {
+				return nil
+			},
+		},
+		{
+			name:        "no matching pods",
+			constraints: []corev1.TopologySpreadConstraint{constraint},
+			pods: []runtime.Object{
+				scheduledPod("other", "node-a", map[string]string{"app": "db"}),
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Topology Spread", "There are no topology domains!", topologySpreadCols)
+				table.Add(
+					row("us-east-1a", 0, 0, 0),
+					row("us-east-1b", 0, 0, 0),
+				)
+				return table
+			},
+		},
+		{
+			name:        "domain exceeds max skew",
+			constraints: []corev1.TopologySpreadConstraint{constraint},
+			pods: []runtime.Object{
+				scheduledPod("web-1", "node-a", map[string]string{"app": "web"}),
+				scheduledPod("web-2", "node-a", map[string]string{"app": "web"}),
+				scheduledPod("web-3", "node-c", map[string]string{"app": "web"}),
+				scheduledPod("web-4", "", map[string]string{"app": "web"}),
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Topology Spread", "There are no topology domains!", topologySpreadCols)
+				table.Add(
+					row("us-east-1a", 2, 2, component.TextStatusWarning),
+					row("us-east-1b", 0, 0, 0),
+				)
+				return table
+			},
+		},
+		{
+			name:        "spread within max skew",
+			constraints: []corev1.TopologySpreadConstraint{constraint},
+			pods: []runtime.Object{
+				scheduledPod("web-1", "node-a", map[string]string{"app": "web"}),
+				scheduledPod("web-2", "node-a", map[string]string{"app": "web"}),
+				scheduledPod("web-3", "node-b", map[string]string{"app": "web"}),
+			},
+			expected: func() component.Component {
+				table := component.NewTable("Topology Spread", "There are no topology domains!", topologySpreadCols)
+				table.Add(
+					row("us-east-1a", 2, 1, 0),
+					row("us-east-1b", 1, 0, 0),
+				)
+				return table
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			tpo := newTestPrinterOptions(controller)
+
+			if len(test.constraints) > 0 {
+				tpo.objectStore.EXPECT().
+					List(ctx, store.Key{APIVersion: "v1", Kind: "Node"}).
+					Return(testutil.ToUnstructuredList(t, nodes...), false, nil)
+				tpo.objectStore.EXPECT().
+					List(ctx, store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}).
+					Return(testutil.ToUnstructuredList(t, test.pods...), false, nil)
+			}
+
+			podSpec := corev1.PodSpec{TopologySpreadConstraints: test.constraints}
+
+			got, err := createTopologySpreadView(ctx, "namespace", podSpec, tpo.ToOptions())
+			require.NoError(t, err)
+
+			expected := test.expected()
+			if expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
@@ -24,6 +24,7 @@ func ValidatingWebhookConfigurationListHandler(ctx context.Context, list *admiss
 
 	cols := component.NewTableCols("Name", "Age")
 	ot := NewObjectTable("Validating Webhook Configurations", "We couldn't find any validating webhook configurations!", cols, options.DashConfig.ObjectStore())
+	ot.SetAgeFilter(options)
 
 	for _, validatingWebhookConfiguration := range list.Items {
 		row := component.TableRow{}
@@ -181,8 +181,10 @@ func Test_NewValidatingWebhook(t *testing.T) {
 					Content: component.NewText("Equivalent"),
 				},
 				{
-					Header:  "Side Effects",
-					Content: component.NewText("Unknown"),
+					Header: "Side Effects",
+					Content: component.NewText("Unknown (dry run requests will be rejected)", func(t *component.Text) {
+						t.SetStatus(component.TextStatusWarning)
+					}),
 				},
 				{
 					Header:  "Timeout",
@@ -190,7 +192,7 @@ func Test_NewValidatingWebhook(t *testing.T) {
 				},
 				{
 					Header:  "Admission Review Versions",
-					Content: component.NewMarkdownText("null\n"),
+					Content: component.NewText("v1beta1"),
 				},
 			}...),
 		},
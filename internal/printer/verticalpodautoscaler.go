@@ -0,0 +1,271 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	verticalPodAutoscalerUpdateModeOff  = "Off"
+	verticalPodAutoscalerUpdateModeAuto = "Auto"
+)
+
+// verticalPodAutoscaler contains the fields of an autoscaling.k8s.io VerticalPodAutoscaler
+// which are printed. The VPA types are not part of the Kubernetes API, so they are extracted
+// from the unstructured custom resource.
+type verticalPodAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   verticalPodAutoscalerSpec   `json:"spec"`
+	Status verticalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+type verticalPodAutoscalerSpec struct {
+	TargetRef    *autoscalingv1.CrossVersionObjectReference `json:"targetRef,omitempty"`
+	UpdatePolicy *verticalPodAutoscalerUpdatePolicy         `json:"updatePolicy,omitempty"`
+}
+
+type verticalPodAutoscalerUpdatePolicy struct {
+	UpdateMode *string `json:"updateMode,omitempty"`
+}
+
+type verticalPodAutoscalerStatus struct {
+	Recommendation *verticalPodAutoscalerRecommendation `json:"recommendation,omitempty"`
+	Conditions     []customResourceCondition            `json:"conditions,omitempty"`
+}
+
+type verticalPodAutoscalerRecommendation struct {
+	ContainerRecommendations []verticalPodAutoscalerContainerRecommendation `json:"containerRecommendations,omitempty"`
+}
+
+type verticalPodAutoscalerContainerRecommendation struct {
+	ContainerName  string              `json:"containerName,omitempty"`
+	Target         corev1.ResourceList `json:"target,omitempty"`
+	LowerBound     corev1.ResourceList `json:"lowerBound,omitempty"`
+	UpperBound     corev1.ResourceList `json:"upperBound,omitempty"`
+	UncappedTarget corev1.ResourceList `json:"uncappedTarget,omitempty"`
+}
+
+func (v *verticalPodAutoscaler) updateMode() string {
+	if v.Spec.UpdatePolicy == nil || v.Spec.UpdatePolicy.UpdateMode == nil {
+		return verticalPodAutoscalerUpdateModeAuto
+	}
+	return *v.Spec.UpdatePolicy.UpdateMode
+}
+
+func toVerticalPodAutoscaler(cr *unstructured.Unstructured) (*verticalPodAutoscaler, error) {
+	if cr == nil {
+		return nil, errors.New("vertical pod autoscaler is nil")
+	}
+
+	vpa := &verticalPodAutoscaler{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, vpa); err != nil {
+		return nil, errors.Wrap(err, "convert vertical pod autoscaler")
+	}
+
+	return vpa, nil
+}
+
+// VerticalPodAutoscalerHandler is a printFunc that prints a VerticalPodAutoscaler.
+func VerticalPodAutoscalerHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	vpa, err := toVerticalPodAutoscaler(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewObject(cr)
+	o.EnableEvents()
+
+	config, err := createVerticalPodAutoscalerConfiguration(vpa, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print verticalpodautoscaler configuration")
+	}
+	o.RegisterConfig(config)
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createVerticalPodAutoscalerRecommendations(ctx, vpa, options)
+		},
+	})
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return createVerticalPodAutoscalerConditions(vpa)
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+func createVerticalPodAutoscalerConfiguration(vpa *verticalPodAutoscaler, options Options) (*component.Summary, error) {
+	if vpa == nil {
+		return nil, errors.New("vertical pod autoscaler is nil")
+	}
+
+	sections := component.SummarySections{}
+
+	if targetRef := vpa.Spec.TargetRef; targetRef != nil {
+		target, err := options.Link.ForGVK(vpa.Namespace, targetRef.APIVersion, targetRef.Kind, targetRef.Name, targetRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		sections.Add("Target", target)
+	} else {
+		sections.AddText("Target", "<none>")
+	}
+
+	updateMode := vpa.updateMode()
+	sections.AddText("Update Mode", updateMode)
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	if updateMode == verticalPodAutoscalerUpdateModeOff {
+		summary.SetAlert(component.NewAlert(component.AlertTypeInfo,
+			"Update mode is Off. Recommendations are advisory only and will not be applied to pods."))
+	}
+
+	return summary, nil
+}
+
+var (
+	verticalPodAutoscalerRecommendationCols = component.NewTableCols("Container", "Resource", "Request",
+		"Target", "Lower Bound", "Upper Bound", "Uncapped Target")
+)
+
+func createVerticalPodAutoscalerRecommendations(ctx context.Context, vpa *verticalPodAutoscaler, options Options) (*component.Table, error) {
+	if vpa == nil {
+		return nil, errors.New("vertical pod autoscaler is nil")
+	}
+
+	table := component.NewTable("Recommendations", "There are no recommendations!", verticalPodAutoscalerRecommendationCols)
+
+	if vpa.Status.Recommendation == nil {
+		return table, nil
+	}
+
+	requests, err := verticalPodAutoscalerTargetRequests(ctx, vpa, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recommendation := range vpa.Status.Recommendation.ContainerRecommendations {
+		var resourceNames []string
+		for name := range recommendation.Target {
+			resourceNames = append(resourceNames, string(name))
+		}
+		sort.Strings(resourceNames)
+
+		for _, name := range resourceNames {
+			resourceName := corev1.ResourceName(name)
+
+			row := component.TableRow{
+				"Container":       component.NewText(recommendation.ContainerName),
+				"Resource":        component.NewText(name),
+				"Request":         verticalPodAutoscalerRequest(requests[recommendation.ContainerName], resourceName, recommendation),
+				"Target":          printResourceListQuantity(recommendation.Target, resourceName),
+				"Lower Bound":     printResourceListQuantity(recommendation.LowerBound, resourceName),
+				"Upper Bound":     printResourceListQuantity(recommendation.UpperBound, resourceName),
+				"Uncapped Target": printResourceListQuantity(recommendation.UncappedTarget, resourceName),
+			}
+
+			table.Add(row)
+		}
+	}
+
+	return table, nil
+}
+
+// verticalPodAutoscalerRequest prints a container's current request. Requests outside of
+// the recommended bounds are flagged since the container is likely mis-sized.
+func verticalPodAutoscalerRequest(requests corev1.ResourceList, name corev1.ResourceName, recommendation verticalPodAutoscalerContainerRecommendation) component.Component {
+	request, ok := requests[name]
+	if !ok {
+		return component.NewText("<none>")
+	}
+
+	text := component.NewText(request.String())
+
+	if lowerBound, ok := recommendation.LowerBound[name]; ok && request.Cmp(lowerBound) < 0 {
+		text.SetStatus(component.TextStatusWarning)
+	}
+	if upperBound, ok := recommendation.UpperBound[name]; ok && request.Cmp(upperBound) > 0 {
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
+
+func printResourceListQuantity(list corev1.ResourceList, name corev1.ResourceName) component.Component {
+	if q, ok := list[name]; ok {
+		return component.NewText(q.String())
+	}
+	return component.NewText("")
+}
+
+// verticalPodAutoscalerTargetRequests returns the resource requests for each container in the
+// target's pod template. If the target can't be found, no requests are returned.
+func verticalPodAutoscalerTargetRequests(ctx context.Context, vpa *verticalPodAutoscaler, options Options) (map[string]corev1.ResourceList, error) {
+	requests := make(map[string]corev1.ResourceList)
+
+	targetRef := vpa.Spec.TargetRef
+	if targetRef == nil {
+		return requests, nil
+	}
+
+	key := store.Key{
+		Namespace:  vpa.Namespace,
+		APIVersion: targetRef.APIVersion,
+		Kind:       targetRef.Kind,
+		Name:       targetRef.Name,
+	}
+
+	u, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get target %s", key)
+	}
+	if u == nil {
+		return requests, nil
+	}
+
+	templateMap, found, err := unstructured.NestedMap(u.Object, "spec", "template")
+	if err != nil || !found {
+		return requests, nil
+	}
+
+	template := corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateMap, &template); err != nil {
+		return nil, errors.Wrapf(err, "convert pod template for %s", key)
+	}
+
+	for _, container := range template.Spec.Containers {
+		requests[container.Name] = container.Resources.Requests
+	}
+
+	return requests, nil
+}
+
+func createVerticalPodAutoscalerConditions(vpa *verticalPodAutoscaler) (*component.Table, error) {
+	if vpa == nil {
+		return nil, errors.New("vertical pod autoscaler is nil")
+	}
+
+	return createCustomResourceConditionsView(vpa.Status.Conditions), nil
+}
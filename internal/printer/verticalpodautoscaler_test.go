@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createVerticalPodAutoscalerConfiguration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("default", "apps/v1", "Deployment", "my-app", "my-app", "/deployment")
+
+	vpa, err := toVerticalPodAutoscaler(testutil.LoadUnstructuredFromFile(t, "verticalpodautoscaler.yaml"))
+	require.NoError(t, err)
+
+	got, err := createVerticalPodAutoscalerConfiguration(vpa, printOptions)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{
+		{Header: "Target", Content: component.NewLink("", "my-app", "/deployment")},
+		{Header: "Update Mode", Content: component.NewText("Off")},
+	}
+	expected := component.NewSummary("Configuration", sections...)
+	expected.SetAlert(component.NewAlert(component.AlertTypeInfo,
+		"Update mode is Off. Recommendations are advisory only and will not be applied to pods."))
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createVerticalPodAutoscalerConfiguration_defaultUpdateMode(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	got, err := createVerticalPodAutoscalerConfiguration(&verticalPodAutoscaler{}, printOptions)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{
+		{Header: "Target", Content: component.NewText("<none>")},
+		{Header: "Update Mode", Content: component.NewText("Auto")},
+	}
+	expected := component.NewSummary("Configuration", sections...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createVerticalPodAutoscalerRecommendations(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	deployment := testutil.CreateDeployment("my-app", func(d *appsv1.Deployment) {
+		d.Spec.Template.Spec.Containers = []corev1.Container{
+			{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				},
+			},
+		}
+	})
+
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "my-app"}
+	tpo.objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), nil)
+
+	vpa, err := toVerticalPodAutoscaler(testutil.LoadUnstructuredFromFile(t, "verticalpodautoscaler.yaml"))
+	require.NoError(t, err)
+
+	got, err := createVerticalPodAutoscalerRecommendations(context.Background(), vpa, printOptions)
+	require.NoError(t, err)
+
+	cpuRequest := component.NewText("1")
+	cpuRequest.SetStatus(component.TextStatusWarning)
+
+	expected := component.NewTable("Recommendations", "There are no recommendations!", verticalPodAutoscalerRecommendationCols)
+	expected.Add(
+		component.TableRow{
+			"Container":       component.NewText("app"),
+			"Resource":        component.NewText("cpu"),
+			"Request":         cpuRequest,
+			"Target":          component.NewText("50m"),
+			"Lower Bound":     component.NewText("25m"),
+			"Upper Bound":     component.NewText("200m"),
+			"Uncapped Target": component.NewText("50m"),
+		},
+		component.TableRow{
+			"Container":       component.NewText("app"),
+			"Resource":        component.NewText("memory"),
+			"Request":         component.NewText("256Mi"),
+			"Target":          component.NewText("262144k"),
+			"Lower Bound":     component.NewText("262144k"),
+			"Upper Bound":     component.NewText("500Mi"),
+			"Uncapped Target": component.NewText("262144k"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createVerticalPodAutoscalerConditions(t *testing.T) {
+	vpa, err := toVerticalPodAutoscaler(testutil.LoadUnstructuredFromFile(t, "verticalpodautoscaler.yaml"))
+	require.NoError(t, err)
+
+	got, err := createVerticalPodAutoscalerConditions(vpa)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Conditions", "There are no conditions!", customResourceConditionsCols)
+	expected.Add(component.TableRow{
+		"Type":                 component.NewText("RecommendationProvided"),
+		"Status":               component.NewText("True"),
+		"Last Transition Time": component.NewTimestamp(time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)),
+		"Message":              component.NewText(""),
+		"Reason":               component.NewText(""),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
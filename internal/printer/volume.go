@@ -6,10 +6,14 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"encoding/json"
 
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -142,6 +146,196 @@ func printVolumes(volumes []corev1.Volume) (component.Component, error) {
 	return table, nil
 }
 
+// printPodVolumes prints a pod's volumes. When the pod has ConfigMap or Secret volumes,
+// a Reference column resolves each against the cache.
+func printPodVolumes(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	out, err := printVolumes(pod.Spec.Volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	table, ok := out.(*component.Table)
+	if !ok {
+		return out, nil
+	}
+
+	references := make([]component.Component, len(pod.Spec.Volumes))
+	hasReferences := false
+	for i, volume := range pod.Spec.Volumes {
+		references[i], err = describeVolumeConfigReferences(ctx, pod.Namespace, volume, options)
+		if err != nil {
+			return nil, err
+		}
+		if references[i] != nil {
+			hasReferences = true
+		}
+	}
+
+	if !hasReferences {
+		return table, nil
+	}
+
+	table.AddColumn("Reference")
+	for i, row := range table.Rows() {
+		if references[i] == nil {
+			references[i] = component.NewText("")
+		}
+		row["Reference"] = references[i]
+	}
+
+	return table, nil
+}
+
+// describeVolumeConfigReferences resolves the ConfigMaps and Secrets a volume references.
+// Volumes without references return nil.
+func describeVolumeConfigReferences(ctx context.Context, namespace string, volume corev1.Volume, options Options) (component.Component, error) {
+	type reference struct {
+		kind     string
+		name     string
+		optional *bool
+	}
+
+	var references []reference
+
+	switch {
+	case volume.Secret != nil:
+		references = append(references, reference{kind: "Secret", name: volume.Secret.SecretName, optional: volume.Secret.Optional})
+	case volume.ConfigMap != nil:
+		references = append(references, reference{kind: "ConfigMap", name: volume.ConfigMap.Name, optional: volume.ConfigMap.Optional})
+	case volume.Projected != nil:
+		for _, source := range volume.Projected.Sources {
+			if source.Secret != nil {
+				references = append(references, reference{kind: "Secret", name: source.Secret.Name, optional: source.Secret.Optional})
+			}
+			if source.ConfigMap != nil {
+				references = append(references, reference{kind: "ConfigMap", name: source.ConfigMap.Name, optional: source.ConfigMap.Optional})
+			}
+		}
+	}
+
+	if len(references) == 0 {
+		return nil, nil
+	}
+
+	var items []component.Component
+	for _, ref := range references {
+		_, item, err := describeConfigReference(ctx, namespace, ref.kind, ref.name, ref.name, ref.optional, options)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if len(items) == 1 {
+		return items[0], nil
+	}
+
+	return component.NewList(nil, items), nil
+}
+
+var (
+	volumeDeviceCols = component.NewTableCols("Container", "Name", "Device Path", "Claim", "Volume Mode")
+)
+
+// printVolumeDevices prints the raw block devices mapped into a pod's containers. Devices
+// backed by a persistent volume claim link to the claim and show its volume mode. A claim
+// which is not in block mode is shown as an error. If no containers have volume devices,
+// no view is returned.
+func printVolumeDevices(ctx context.Context, pod *corev1.Pod, options Options) (component.Component, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	volumes := map[string]corev1.Volume{}
+	for _, volume := range pod.Spec.Volumes {
+		volumes[volume.Name] = volume
+	}
+
+	table := component.NewTable("Volume Devices", "There are no volume devices!", volumeDeviceCols)
+
+	containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, device := range container.VolumeDevices {
+			row := component.TableRow{
+				"Container":   component.NewText(container.Name),
+				"Name":        component.NewText(device.Name),
+				"Device Path": component.NewText(device.DevicePath),
+				"Claim":       component.NewText("<none>"),
+				"Volume Mode": component.NewText("<unknown>"),
+			}
+
+			volume, ok := volumes[device.Name]
+			if ok && volume.PersistentVolumeClaim != nil {
+				claimName := volume.PersistentVolumeClaim.ClaimName
+
+				claimLink, err := options.Link.ForGVK(pod.Namespace, "v1", "PersistentVolumeClaim", claimName, claimName)
+				if err != nil {
+					row["Claim"] = component.NewText(claimName)
+				} else {
+					row["Claim"] = claimLink
+				}
+
+				volumeMode, err := persistentVolumeClaimVolumeMode(ctx, pod.Namespace, claimName, options)
+				if err != nil {
+					return nil, err
+				}
+				row["Volume Mode"] = volumeMode
+			}
+
+			table.Add(row)
+		}
+	}
+
+	if table.IsEmpty() {
+		return nil, nil
+	}
+
+	return table, nil
+}
+
+// persistentVolumeClaimVolumeMode prints the volume mode of a persistent volume claim.
+// Claims which are not in block mode can't back a volume device.
+func persistentVolumeClaimVolumeMode(ctx context.Context, namespace, name string, options Options) (*component.Text, error) {
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "PersistentVolumeClaim",
+		Name:       name,
+	}
+
+	u, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get persistent volume claim %s", name)
+	}
+
+	if u == nil {
+		return component.NewText("<unknown>"), nil
+	}
+
+	claim := &corev1.PersistentVolumeClaim{}
+	if err := kubernetes.FromUnstructured(u, claim); err != nil {
+		return nil, err
+	}
+
+	volumeMode := corev1.PersistentVolumeFilesystem
+	if claim.Spec.VolumeMode != nil {
+		volumeMode = *claim.Spec.VolumeMode
+	}
+
+	text := component.NewText(string(volumeMode))
+	if volumeMode == corev1.PersistentVolumeBlock {
+		text.SetStatus(component.TextStatusOK)
+	} else {
+		text.SetStatus(component.TextStatusError)
+	}
+
+	return text, nil
+}
+
 func describeVolumeSource(source interface{}) string {
 	data, _ := json.Marshal(source)
 	return string(data)
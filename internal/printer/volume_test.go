@@ -6,13 +6,16 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"testing"
 
-	"github.com/vmware-tanzu/octant/pkg/view/component"
-
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 
-	"github.com/stretchr/testify/require"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 func Test_printVolumes(t *testing.T) {
@@ -428,3 +431,207 @@ func Test_printVolumes(t *testing.T) {
 		})
 	}
 }
+
+func Test_printPodVolumes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+	tpo := newTestPrinterOptions(controller)
+
+	optional := true
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name:         "cache",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+		{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+				},
+			},
+		},
+		{
+			Name: "tls",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "tls", Optional: &optional},
+			},
+		},
+	}
+
+	tpo.objectStore.EXPECT().
+		Get(ctx, store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "config"}).
+		Return(testutil.ToUnstructured(t, testutil.CreateConfigMap("config")), nil)
+	tpo.objectStore.EXPECT().
+		Get(ctx, store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Secret", Name: "tls"}).
+		Return(nil, nil)
+	tpo.PathForGVK("namespace", "v1", "ConfigMap", "config", "config", "/config")
+
+	got, err := printPodVolumes(ctx, pod, tpo.ToOptions())
+	require.NoError(t, err)
+
+	expected, err := printVolumes(pod.Spec.Volumes)
+	require.NoError(t, err)
+
+	missing := component.NewText("tls (optional, missing)")
+	missing.SetStatus(component.TextStatusWarning)
+
+	table := expected.(*component.Table)
+	table.AddColumn("Reference")
+	table.Rows()[0]["Reference"] = component.NewText("")
+	table.Rows()[1]["Reference"] = component.NewLink("", "config", "/config")
+	table.Rows()[2]["Reference"] = missing
+
+	component.AssertEqual(t, table, got)
+}
+
+func Test_printVolumeDevices(t *testing.T) {
+	blockMode := corev1.PersistentVolumeBlock
+
+	blockClaim := testutil.CreatePersistentVolumeClaim("block-claim")
+	blockClaim.Spec.VolumeMode = &blockMode
+
+	fileClaim := testutil.CreatePersistentVolumeClaim("file-claim")
+
+	claimKey := func(name string) store.Key {
+		return store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: name}
+	}
+
+	blockText := component.NewText("Block")
+	blockText.SetStatus(component.TextStatusOK)
+
+	fileText := component.NewText("Filesystem")
+	fileText.SetStatus(component.TextStatusError)
+
+	tests := []struct {
+		name     string
+		pod      func() *corev1.Pod
+		setup    func(t *testing.T, tpo *testPrinterOptions)
+		expected component.Component
+	}{
+		{
+			name: "no volume devices",
+			pod: func() *corev1.Pod {
+				pod := testutil.CreatePod("pod")
+				pod.Spec.Containers = []corev1.Container{{Name: "app"}}
+				return pod
+			},
+			expected: nil,
+		},
+		{
+			name: "volume devices",
+			pod: func() *corev1.Pod {
+				pod := testutil.CreatePod("pod")
+				pod.Spec.Volumes = []corev1.Volume{
+					{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "block-claim"},
+						},
+					},
+					{
+						Name: "log",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "file-claim"},
+						},
+					},
+					{
+						Name: "missing",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "missing-claim"},
+						},
+					},
+					{
+						Name:         "scratch",
+						VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					},
+				}
+				pod.Spec.InitContainers = []corev1.Container{
+					{
+						Name:          "init",
+						VolumeDevices: []corev1.VolumeDevice{{Name: "data", DevicePath: "/dev/xvda"}},
+					},
+				}
+				pod.Spec.Containers = []corev1.Container{
+					{
+						Name: "db",
+						VolumeDevices: []corev1.VolumeDevice{
+							{Name: "log", DevicePath: "/dev/xvdb"},
+							{Name: "missing", DevicePath: "/dev/xvdc"},
+							{Name: "scratch", DevicePath: "/dev/xvdd"},
+						},
+					},
+				}
+				return pod
+			},
+			setup: func(t *testing.T, tpo *testPrinterOptions) {
+				tpo.objectStore.EXPECT().Get(gomock.Any(), claimKey("block-claim")).
+					Return(testutil.ToUnstructured(t, blockClaim), nil)
+				tpo.objectStore.EXPECT().Get(gomock.Any(), claimKey("file-claim")).
+					Return(testutil.ToUnstructured(t, fileClaim), nil)
+				tpo.objectStore.EXPECT().Get(gomock.Any(), claimKey("missing-claim")).
+					Return(nil, nil)
+				tpo.PathForGVK("namespace", "v1", "PersistentVolumeClaim", "block-claim", "block-claim", "/block-claim")
+				tpo.PathForGVK("namespace", "v1", "PersistentVolumeClaim", "file-claim", "file-claim", "/file-claim")
+				tpo.PathForGVK("namespace", "v1", "PersistentVolumeClaim", "missing-claim", "missing-claim", "/missing-claim")
+			},
+			expected: component.NewTableWithRows("Volume Devices", "There are no volume devices!", volumeDeviceCols,
+				[]component.TableRow{
+					{
+						"Container":   component.NewText("init"),
+						"Name":        component.NewText("data"),
+						"Device Path": component.NewText("/dev/xvda"),
+						"Claim":       component.NewLink("", "block-claim", "/block-claim"),
+						"Volume Mode": blockText,
+					},
+					{
+						"Container":   component.NewText("db"),
+						"Name":        component.NewText("log"),
+						"Device Path": component.NewText("/dev/xvdb"),
+						"Claim":       component.NewLink("", "file-claim", "/file-claim"),
+						"Volume Mode": fileText,
+					},
+					{
+						"Container":   component.NewText("db"),
+						"Name":        component.NewText("missing"),
+						"Device Path": component.NewText("/dev/xvdc"),
+						"Claim":       component.NewLink("", "missing-claim", "/missing-claim"),
+						"Volume Mode": component.NewText("<unknown>"),
+					},
+					{
+						"Container":   component.NewText("db"),
+						"Name":        component.NewText("scratch"),
+						"Device Path": component.NewText("/dev/xvdd"),
+						"Claim":       component.NewText("<none>"),
+						"Volume Mode": component.NewText("<unknown>"),
+					},
+				}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			if test.setup != nil {
+				test.setup(t, tpo)
+			}
+
+			got, err := printVolumeDevices(context.Background(), test.pod(), tpo.ToOptions())
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
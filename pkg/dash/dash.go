@@ -27,6 +27,7 @@ import (
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/link"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/modules/applications"
@@ -59,6 +60,9 @@ type Options struct {
 	ClientBurst            int
 	UserAgent              string
 	BuildInfo              config.BuildInfo
+	// PathResolver routes printed links to custom views, e.g. a plugin's views of its
+	// custom resources. If nil, links use the module paths.
+	PathResolver link.PathResolver
 }
 
 type Runner struct {
@@ -278,6 +282,7 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, options Options
 		options.Context,
 		restConfigOptions,
 		buildInfo)
+	dashConfig.SetPathResolver(options.PathResolver)
 
 	if err := watchConfigs(ctx, dashConfig, options.KubeConfig); err != nil {
 		return nil, nil, fmt.Errorf("set up config watcher: %w", err)
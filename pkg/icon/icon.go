@@ -27,6 +27,7 @@ const (
 	ClusterOverviewNamespace          = "ns"
 	ClusterOverviewNode               = "node"
 	ClusterOverviewPersistentVolume   = "pv"
+	ClusterOverviewRuntimeClass       = "container"
 
 	Configuration       = "cog"
 	ConfigurationPlugin = "plugin"
@@ -12,6 +12,7 @@ const (
 	typeCardList           = "cardList"
 	typeCodeBlock          = "codeBlock"
 	typeContainers         = "containers"
+	typeCountdown          = "countdown"
 	typeDonutChart         = "donutChart"
 	typeEditor             = "editor"
 	typeError              = "error"
@@ -27,6 +28,7 @@ const (
 	typeList               = "list"
 	typeLoading            = "loading"
 	typeLogs               = "logs"
+	typeMultiBar           = "multiBar"
 	typePodStatus          = "podStatus"
 	typePort               = "port"
 	typePorts              = "ports"
@@ -34,11 +36,13 @@ const (
 	typeResourceViewer     = "resourceViewer"
 	typeSelectors          = "selectors"
 	typeSingleStat         = "singleStat"
+	typeStatusBadges       = "statusBadges"
 	typeSummary            = "summary"
 	typeTable              = "table"
 	typeTerminal           = "terminal"
 	typeText               = "text"
 	typeTimestamp          = "timestamp"
+	typeTree               = "tree"
 	typeYAML               = "yaml"
 )
 
@@ -125,6 +125,8 @@ type Metadata struct {
 	Type     string           `json:"type"`
 	Title    []TitleComponent `json:"title,omitempty"`
 	Accessor string           `json:"accessor,omitempty"`
+	// Active is true if the component is the section or tab shown when its view loads.
+	Active bool `json:"active,omitempty"`
 }
 
 // SetTitleText sets the title using text components.
@@ -143,6 +145,7 @@ func (m *Metadata) UnmarshalJSON(data []byte) error {
 		Type     string        `json:"type,omitempty"`
 		Title    []TypedObject `json:"title,omitempty"`
 		Accessor string        `json:"accessor,omitempty"`
+		Active   bool          `json:"active,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -151,6 +154,7 @@ func (m *Metadata) UnmarshalJSON(data []byte) error {
 
 	m.Type = x.Type
 	m.Accessor = x.Accessor
+	m.Active = x.Active
 
 	for _, title := range x.Title {
 		vc, err := title.ToComponent()
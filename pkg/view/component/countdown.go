@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// DefaultCountdownWarningThreshold is the remaining time at which a countdown is shown as a warning.
+	DefaultCountdownWarningThreshold = 30 * 24 * time.Hour
+	// DefaultCountdownErrorThreshold is the remaining time at which a countdown is shown as an error.
+	DefaultCountdownErrorThreshold = 7 * 24 * time.Hour
+)
+
+// CountdownConfig is the contents of Countdown.
+type CountdownConfig struct {
+	// Target is the time, in seconds since the epoch, the countdown counts down to.
+	Target int64 `json:"target"`
+	// WarningThreshold is the remaining number of seconds at which the countdown is shown as a warning.
+	WarningThreshold int64 `json:"warningThreshold,omitempty"`
+	// ErrorThreshold is the remaining number of seconds at which the countdown is shown as an error.
+	ErrorThreshold int64 `json:"errorThreshold,omitempty"`
+}
+
+// Countdown is a component which displays the time remaining until a deadline.
+// The frontend updates the remaining time as it elapses.
+type Countdown struct {
+	base
+	Config CountdownConfig `json:"config"`
+}
+
+var _ Component = (*Countdown)(nil)
+
+// CountdownOption is an option for configuring a Countdown.
+type CountdownOption func(c *Countdown)
+
+// WithCountdownThresholds sets the remaining durations at which the
+// countdown is shown as a warning or an error.
+func WithCountdownThresholds(warning, err time.Duration) CountdownOption {
+	return func(c *Countdown) {
+		c.Config.WarningThreshold = int64(warning.Seconds())
+		c.Config.ErrorThreshold = int64(err.Seconds())
+	}
+}
+
+// NewCountdown creates a countdown component.
+func NewCountdown(target time.Time, options ...CountdownOption) *Countdown {
+	c := &Countdown{
+		base: newBase(typeCountdown, nil),
+		Config: CountdownConfig{
+			Target: target.Unix(),
+		},
+	}
+
+	WithCountdownThresholds(DefaultCountdownWarningThreshold, DefaultCountdownErrorThreshold)(c)
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// Target returns the time the countdown counts down to.
+func (c *Countdown) Target() time.Time {
+	return time.Unix(c.Config.Target, 0)
+}
+
+// Status returns the status of the countdown at a point in time. Expired
+// countdowns are errors.
+func (c *Countdown) Status(now time.Time) TextStatus {
+	remaining := c.Config.Target - now.Unix()
+
+	switch {
+	case remaining <= 0 || remaining <= c.Config.ErrorThreshold:
+		return TextStatusError
+	case remaining <= c.Config.WarningThreshold:
+		return TextStatusWarning
+	default:
+		return TextStatusOK
+	}
+}
+
+type countdownMarshal Countdown
+
+// MarshalJSON implements json.Marshaler.
+func (c *Countdown) MarshalJSON() ([]byte, error) {
+	m := countdownMarshal(*c)
+	m.Metadata.Type = typeCountdown
+	return json.Marshal(&m)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Countdown_Marshal(t *testing.T) {
+	target := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name     string
+		input    Component
+		expected string
+	}{
+		{
+			name:  "default thresholds",
+			input: NewCountdown(target),
+			expected: `
+			{
+				"metadata": {
+					"type": "countdown"
+				},
+				"config": {
+					"target": 1600000000,
+					"warningThreshold": 2592000,
+					"errorThreshold": 604800
+				}
+			}
+`,
+		},
+		{
+			name:  "custom thresholds",
+			input: NewCountdown(target, WithCountdownThresholds(time.Hour, 10*time.Minute)),
+			expected: `
+			{
+				"metadata": {
+					"type": "countdown"
+				},
+				"config": {
+					"target": 1600000000,
+					"warningThreshold": 3600,
+					"errorThreshold": 600
+				}
+			}
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func Test_Countdown_Status(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name     string
+		target   time.Time
+		expected TextStatus
+	}{
+		{
+			name:     "expired",
+			target:   now.Add(-time.Minute),
+			expected: TextStatusError,
+		},
+		{
+			name:     "within error threshold",
+			target:   now.Add(5 * time.Minute),
+			expected: TextStatusError,
+		},
+		{
+			name:     "within warning threshold",
+			target:   now.Add(30 * time.Minute),
+			expected: TextStatusWarning,
+		},
+		{
+			name:     "outside thresholds",
+			target:   now.Add(2 * time.Hour),
+			expected: TextStatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewCountdown(test.target, WithCountdownThresholds(time.Hour, 10*time.Minute))
+			assert.Equal(t, test.expected, c.Status(now))
+		})
+	}
+}
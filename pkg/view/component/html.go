@@ -0,0 +1,412 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const htmlStyle = `body { font-family: sans-serif; font-size: 14px; color: #313131; margin: 1rem; }
+h1 { font-size: 20px; } h2 { font-size: 16px; }
+table { border-collapse: collapse; margin-bottom: 1rem; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f2f2f2; }
+dl { display: grid; grid-template-columns: max-content auto; gap: 4px 16px; }
+dt { font-weight: bold; }
+dd { margin: 0; }
+pre { background: #f7f7f7; padding: 8px; white-space: pre-wrap; }
+.label { display: inline-block; border: 1px solid #ccc; border-radius: 3px; padding: 0 4px; margin: 0 4px 2px 0; }
+.status-ok { color: #2f8400; } .status-warning { color: #c25400; } .status-error { color: #c21d00; }
+.alert { border: 1px solid #ccc; padding: 8px; margin-bottom: 8px; }
+.unsupported { color: #666; font-style: italic; }`
+
+// RenderHTML renders a component as a static, self-contained HTML document.
+// The document is intended for export and printing, so it is read-only:
+// actions are omitted and links which are not absolute URLs are rendered as text.
+func RenderHTML(c Component) ([]byte, error) {
+	if c == nil {
+		return nil, errors.New("component is nil")
+	}
+
+	r := &htmlRenderer{}
+
+	title := r.titleText(c.GetMetadata().Title)
+	if title == "" {
+		title = "Octant"
+	}
+
+	r.printf("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n", html.EscapeString(title))
+	r.printf("<style>\n%s\n</style>\n</head>\n<body>\n", htmlStyle)
+
+	if err := r.render(c); err != nil {
+		return nil, err
+	}
+
+	r.printf("</body>\n</html>\n")
+
+	return r.buf.Bytes(), nil
+}
+
+type htmlRenderer struct {
+	buf bytes.Buffer
+}
+
+func (r *htmlRenderer) printf(format string, a ...interface{}) {
+	fmt.Fprintf(&r.buf, format, a...)
+}
+
+func (r *htmlRenderer) text(s string) {
+	r.buf.WriteString(html.EscapeString(s))
+}
+
+func (r *htmlRenderer) titleText(title []TitleComponent) string {
+	var parts []string
+	for _, tc := range title {
+		switch t := tc.(type) {
+		case *Text:
+			parts = append(parts, t.Config.Text)
+		case *Link:
+			parts = append(parts, t.Config.Text)
+		}
+	}
+	return strings.Join(parts, " / ")
+}
+
+func (r *htmlRenderer) heading(level int, c Component) {
+	if title := r.titleText(c.GetMetadata().Title); title != "" {
+		r.printf("<h%d>", level)
+		r.text(title)
+		r.printf("</h%d>\n", level)
+	}
+}
+
+func (r *htmlRenderer) render(c Component) error {
+	if c == nil {
+		return nil
+	}
+
+	switch t := c.(type) {
+	case *Text:
+		r.status(t.Config.Status, t.Config.Text)
+	case *Link:
+		r.link(t)
+	case *Timestamp:
+		r.text(time.Unix(t.Config.Timestamp, 0).UTC().Format(time.RFC3339))
+	case *Countdown:
+		r.countdown(t)
+	case *Labels:
+		r.keyValues(t.Config.Labels)
+	case *Annotations:
+		r.keyValues(t.Config.Annotations)
+	case *Containers:
+		for _, container := range t.Config.Containers {
+			r.printf(`<div class="label">`)
+			r.text(fmt.Sprintf("%s: %s", container.Name, container.Image))
+			r.printf("</div>")
+		}
+	case *Selectors:
+		for _, selector := range t.Config.Selectors {
+			r.printf(`<span class="label">`)
+			r.text(r.selectorText(selector))
+			r.printf("</span>")
+		}
+	case *StatusBadges:
+		if t.Config.Text != "" {
+			r.text(t.Config.Text)
+			r.printf(" ")
+		}
+		for _, badge := range t.Config.Badges {
+			r.printf(`<span class="label">`)
+			r.status(badge.Status, badge.Label)
+			r.printf("</span>")
+		}
+	case *Code:
+		r.printf("<pre>")
+		r.text(t.Config.Code)
+		r.printf("</pre>\n")
+	case *YAML:
+		r.printf("<pre>")
+		r.text(t.Config.Data)
+		r.printf("</pre>\n")
+	case *Error:
+		r.status(TextStatusError, t.Config.Data)
+	case *List:
+		r.heading(2, t)
+		for _, item := range t.Config.Items {
+			r.printf("<div>\n")
+			if err := r.render(item); err != nil {
+				return err
+			}
+			r.printf("</div>\n")
+		}
+	case *FlexLayout:
+		for _, section := range t.Config.Sections {
+			for _, item := range section {
+				r.printf("<section>\n")
+				if err := r.render(item.View); err != nil {
+					return err
+				}
+				r.printf("</section>\n")
+			}
+		}
+	case *Card:
+		r.heading(2, t)
+		r.alert(t.Config.Alert)
+		if err := r.render(t.Config.Body); err != nil {
+			return err
+		}
+	case *CardList:
+		r.heading(2, t)
+		for i := range t.Config.Cards {
+			if err := r.render(&t.Config.Cards[i]); err != nil {
+				return err
+			}
+		}
+	case *Summary:
+		return r.summary(t)
+	case *Table:
+		return r.table(t)
+	case *Quadrant:
+		r.quadrant(t)
+	case *Tree:
+		r.heading(2, t)
+		r.treeNodes(t.Config.Nodes)
+	case *MultiBar:
+		r.multiBar(t)
+	default:
+		r.printf(`<span class="unsupported">`)
+		r.text("This component is not supported in HTML export")
+		r.printf("</span>")
+	}
+
+	return nil
+}
+
+func (r *htmlRenderer) status(status TextStatus, s string) {
+	class := ""
+	switch status {
+	case TextStatusOK:
+		class = "status-ok"
+	case TextStatusWarning:
+		class = "status-warning"
+	case TextStatusError:
+		class = "status-error"
+	}
+
+	if class == "" {
+		r.text(s)
+		return
+	}
+
+	r.printf(`<span class="%s">`, class)
+	r.text(s)
+	r.printf("</span>")
+}
+
+// link renders a link. Relative references point into a running Octant,
+// so they are rendered as plain text.
+func (r *htmlRenderer) link(l *Link) {
+	ref := l.Config.Ref
+	if !strings.HasPrefix(ref, "http://") && !strings.HasPrefix(ref, "https://") {
+		r.status(l.Config.Status, l.Config.Text)
+		return
+	}
+
+	r.printf(`<a href="%s">`, html.EscapeString(ref))
+	r.text(l.Config.Text)
+	r.printf("</a>")
+}
+
+// countdown renders a countdown relative to the time the document was rendered.
+func (r *htmlRenderer) countdown(c *Countdown) {
+	now := time.Now()
+	target := c.Target()
+
+	if !target.After(now) {
+		r.status(TextStatusError, "expired "+target.UTC().Format(time.RFC3339))
+		return
+	}
+
+	r.status(c.Status(now), fmt.Sprintf("expires in %s (%s)", humanDuration(target.Sub(now)), target.UTC().Format(time.RFC3339)))
+}
+
+func humanDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+}
+
+func (r *htmlRenderer) alert(alert *Alert) {
+	if alert == nil {
+		return
+	}
+
+	r.printf(`<div class="alert">`)
+	r.text(fmt.Sprintf("%s: %s", alert.Type, alert.Message))
+	r.printf("</div>\n")
+}
+
+func (r *htmlRenderer) keyValues(m map[string]string) {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		r.printf(`<span class="label">`)
+		r.text(fmt.Sprintf("%s:%s", k, m[k]))
+		r.printf("</span>")
+	}
+}
+
+func (r *htmlRenderer) selectorText(selector Selector) string {
+	switch s := selector.(type) {
+	case *LabelSelector:
+		return fmt.Sprintf("%s:%s", s.Config.Key, s.Config.Value)
+	case *ExpressionSelector:
+		return fmt.Sprintf("%s %s %s", s.Config.Key, s.Config.Operator, strings.Join(s.Config.Values, ","))
+	default:
+		return selector.Name()
+	}
+}
+
+func (r *htmlRenderer) summary(s *Summary) error {
+	r.heading(2, s)
+	r.alert(s.Config.Alert)
+
+	r.printf("<dl>\n")
+	for _, section := range s.Config.Sections {
+		r.printf("<dt>")
+		r.text(section.Header)
+		r.printf("</dt>\n<dd>")
+		if err := r.render(section.Content); err != nil {
+			return errors.Wrapf(err, "render summary section %q", section.Header)
+		}
+		r.printf("</dd>\n")
+	}
+	r.printf("</dl>\n")
+
+	return nil
+}
+
+func (r *htmlRenderer) table(t *Table) error {
+	r.heading(2, t)
+
+	if len(t.Config.Rows) == 0 {
+		r.printf("<p>")
+		r.text(t.Config.EmptyContent)
+		r.printf("</p>\n")
+		return nil
+	}
+
+	r.printf("<table>\n<thead>\n<tr>")
+	for _, col := range t.Config.Columns {
+		r.printf("<th>")
+		r.text(col.Name)
+		r.printf("</th>")
+	}
+	r.printf("</tr>\n</thead>\n<tbody>\n")
+
+	for _, row := range t.Config.Rows {
+		r.printf("<tr>")
+		for _, col := range t.Config.Columns {
+			r.printf("<td>")
+			if err := r.render(row[col.Accessor]); err != nil {
+				return errors.Wrapf(err, "render table column %q", col.Name)
+			}
+			r.printf("</td>")
+		}
+		r.printf("</tr>\n")
+	}
+
+	r.printf("</tbody>\n</table>\n")
+
+	return nil
+}
+
+func (r *htmlRenderer) treeNodes(nodes []TreeNode) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	r.printf("<ul>\n")
+	for _, node := range nodes {
+		r.printf("<li>")
+		if node.Ref != "" {
+			r.link(NewLink("", node.Label, node.Ref))
+		} else {
+			r.status(node.Status, node.Label)
+		}
+		if node.Truncated {
+			r.text(" …")
+		}
+		r.printf("\n")
+		r.treeNodes(node.Children)
+		r.printf("</li>\n")
+	}
+	r.printf("</ul>\n")
+}
+
+func (r *htmlRenderer) multiBar(m *MultiBar) {
+	for i, entry := range m.Config.Entries {
+		if i > 0 {
+			r.text(", ")
+		}
+
+		percent, ok := entry.Percent()
+		if !ok {
+			r.text(fmt.Sprintf("%s unavailable", entry.Label))
+			continue
+		}
+
+		s := fmt.Sprintf("%s %.0f%%", entry.Label, percent)
+		if entry.Text != "" {
+			s = fmt.Sprintf("%s (%s)", s, entry.Text)
+		}
+
+		status := TextStatusOK
+		if m.Config.Threshold > 0 && percent > m.Config.Threshold {
+			status = TextStatusError
+		}
+		r.status(status, s)
+	}
+}
+
+func (r *htmlRenderer) quadrant(q *Quadrant) {
+	r.heading(2, q)
+
+	r.printf("<table>\n")
+	for _, row := range [][]QuadrantValue{
+		{q.Config.NW, q.Config.NE},
+		{q.Config.SW, q.Config.SE},
+	} {
+		r.printf("<tr>")
+		for _, value := range row {
+			r.printf("<td><strong>")
+			r.text(value.Value)
+			r.printf("</strong><br>")
+			r.text(value.Label)
+			r.printf("</td>")
+		}
+		r.printf("</tr>\n")
+	}
+	r.printf("</table>\n")
+}
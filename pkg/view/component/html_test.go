@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderHTML(t *testing.T) {
+	summary := NewSummary("Configuration",
+		SummarySection{Header: "Replicas", Content: NewText("3")},
+		SummarySection{Header: "Owner", Content: NewLink("", "deployment", "/overview/namespace/default/workloads/deployments/deployment")},
+		SummarySection{Header: "Docs", Content: NewLink("", "docs", "https://example.com/docs?a=1&b=2")},
+		SummarySection{Header: "Created", Content: NewTimestamp(time.Unix(0, 0))},
+	)
+	summary.SetAlert(NewAlert(AlertTypeWarning, "check <this>"))
+
+	table := NewTable("Pods", "There are no pods!", NewTableCols("Name", "Status"))
+	table.Add(TableRow{
+		"Name":   NewText("pod"),
+		"Status": NewText("Failed", func(t *Text) { t.SetStatus(TextStatusError) }),
+	})
+
+	tests := []struct {
+		name        string
+		component   Component
+		contains    []string
+		notContains []string
+		isErr       bool
+	}{
+		{
+			name:      "summary",
+			component: summary,
+			contains: []string{
+				"<title>Configuration</title>",
+				"<h2>Configuration</h2>",
+				"<dt>Replicas</dt>",
+				"<dd>3</dd>",
+				"<dd>deployment</dd>",
+				`<a href="https://example.com/docs?a=1&amp;b=2">docs</a>`,
+				"<dd>1970-01-01T00:00:00Z</dd>",
+				"warning: check &lt;this&gt;",
+			},
+			notContains: []string{"/overview/namespace"},
+		},
+		{
+			name:      "table",
+			component: table,
+			contains: []string{
+				"<th>Name</th><th>Status</th>",
+				`<tr><td>pod</td><td><span class="status-error">Failed</span></td></tr>`,
+			},
+		},
+		{
+			name:      "empty table",
+			component: NewTable("Pods", "There are no pods!", NewTableCols("Name")),
+			contains:  []string{"<p>There are no pods!</p>"},
+		},
+		{
+			name:      "quadrant",
+			component: NewQuadrant("Status"),
+			contains:  []string{"<h2>Status</h2>", "<table>"},
+		},
+		{
+			name: "multi bar",
+			component: NewMultiBar("Utilization", 90,
+				NewMultiBarEntry("cpu", 1.9, 2, "1900m/2"),
+				NewMultiBarEntry("memory", 1, 4, ""),
+				NewUnavailableMultiBarEntry("pods"),
+			),
+			contains: []string{
+				`<span class="status-error">cpu 95% (1900m/2)</span>, <span class="status-ok">memory 25%</span>, pods unavailable`,
+			},
+		},
+		{
+			name: "tree",
+			component: NewTree("Owners", NewTreeNode("deployment", "/deployment",
+				NewTreeNode("replica-set", ""),
+			)),
+			contains: []string{"<h2>Owners</h2>", "<ul>\n<li>deployment\n<ul>\n<li>replica-set\n</li>\n</ul>\n</li>\n</ul>"},
+		},
+		{
+			name:      "flex layout",
+			component: NewFlexLayout("Summary"),
+			contains:  []string{"<title>Summary</title>"},
+		},
+		{
+			name:      "expired countdown",
+			component: NewCountdown(time.Unix(0, 0)),
+			contains:  []string{`<span class="status-error">expired 1970-01-01T00:00:00Z</span>`},
+		},
+		{
+			name:      "countdown",
+			component: NewCountdown(time.Now().Add(72*time.Hour + time.Minute)),
+			contains:  []string{`<span class="status-error">expires in 3d`},
+		},
+		{
+			name:      "unsupported",
+			component: NewIFrame("https://example.com", "frame"),
+			contains:  []string{"This component is not supported in HTML export"},
+		},
+		{
+			name:  "nil component",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := RenderHTML(test.component)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, s := range test.contains {
+				assert.Contains(t, string(got), s)
+			}
+			for _, s := range test.notContains {
+				assert.NotContains(t, string(got), s)
+			}
+		})
+	}
+}
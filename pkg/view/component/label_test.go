@@ -30,6 +30,19 @@ func Test_Labels_Marshal(t *testing.T) {
 			}),
 			expectedPath: "labels.json",
 		},
+		{
+			name: "with links",
+			input: func() *component.Labels {
+				labels := component.NewLabels(map[string]string{
+					"foo":            "bar",
+					"controller-uid": "uid",
+				})
+				labels.AddLink("foo", "/pods?filters=foo%3Abar")
+				labels.AddLink("controller-uid", "/pods?filters=controller-uid%3Auid")
+				return labels
+			}(),
+			expectedPath: "labels_links.json",
+		},
 	}
 
 	for _, tc := range cases {
@@ -25,6 +25,8 @@ type Labels struct {
 // LabelsConfig is the contents of Labels
 type LabelsConfig struct {
 	Labels map[string]string `json:"labels"`
+	// Links maps label keys to the references their labels navigate to.
+	Links map[string]string `json:"links,omitempty"`
 }
 
 // NewLabels creates a labels component
@@ -37,6 +39,14 @@ func NewLabels(labels map[string]string) *Labels {
 	}
 }
 
+// AddLink sets the reference the label with key navigates to.
+func (t *Labels) AddLink(key, ref string) {
+	if t.Config.Links == nil {
+		t.Config.Links = make(map[string]string)
+	}
+	t.Config.Links[key] = ref
+}
+
 // GetMetadata accesses the components metadata. Implements Component.
 func (t *Labels) GetMetadata() Metadata {
 	return t.Metadata
@@ -54,6 +64,15 @@ func (t *Labels) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	for k, ref := range t.Config.Links {
+		if _, ok := filtered.Config.Labels[k]; ok {
+			if filtered.Config.Links == nil {
+				filtered.Config.Links = make(map[string]string)
+			}
+			filtered.Config.Links[k] = ref
+		}
+	}
+
 	m := labelsMarshal(*filtered)
 	m.Metadata.Type = typeLabels
 	m.Metadata.Title = t.Metadata.Title
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// MultiBarEntry is a labeled value out of a maximum in a MultiBar.
+type MultiBarEntry struct {
+	// Label names the metric, e.g. cpu.
+	Label string `json:"label"`
+	// Value is the current value of the metric.
+	Value float64 `json:"value"`
+	// Max is the value at which the bar is full.
+	Max float64 `json:"max"`
+	// Text optionally describes the value, e.g. 500m/2.
+	Text string `json:"text,omitempty"`
+	// Unavailable is true if the value is unknown. Unavailable entries are rendered
+	// as text instead of as a bar.
+	Unavailable bool `json:"unavailable,omitempty"`
+}
+
+// NewMultiBarEntry creates a multi bar entry.
+func NewMultiBarEntry(label string, value, max float64, text string) MultiBarEntry {
+	return MultiBarEntry{
+		Label: label,
+		Value: value,
+		Max:   max,
+		Text:  text,
+	}
+}
+
+// NewUnavailableMultiBarEntry creates a multi bar entry for a metric whose value is unknown.
+func NewUnavailableMultiBarEntry(label string) MultiBarEntry {
+	return MultiBarEntry{
+		Label:       label,
+		Unavailable: true,
+	}
+}
+
+// Percent returns the entry's value as a percentage of its maximum. Unavailable entries
+// and entries without a maximum are not available as a percentage.
+func (e MultiBarEntry) Percent() (float64, bool) {
+	if e.Unavailable || e.Max <= 0 {
+		return 0, false
+	}
+	return e.Value / e.Max * 100, true
+}
+
+// MultiBarConfig is the contents of MultiBar.
+type MultiBarConfig struct {
+	// Entries are the bars, in the order they are displayed.
+	Entries []MultiBarEntry `json:"entries"`
+	// Threshold is the percentage above which a bar is displayed as an error.
+	Threshold float64 `json:"threshold,omitempty"`
+}
+
+// MultiBar is a component which renders several labeled values as stacked bars, e.g.
+// the utilization of a node's resources in a table cell.
+type MultiBar struct {
+	base
+	Config MultiBarConfig `json:"config"`
+}
+
+var _ Component = (*MultiBar)(nil)
+
+// NewMultiBar creates a multi bar component. Bars over threshold percent are displayed
+// as errors.
+func NewMultiBar(title string, threshold float64, entries ...MultiBarEntry) *MultiBar {
+	if entries == nil {
+		entries = []MultiBarEntry{}
+	}
+
+	return &MultiBar{
+		base: newBase(typeMultiBar, TitleFromString(title)),
+		Config: MultiBarConfig{
+			Entries:   entries,
+			Threshold: threshold,
+		},
+	}
+}
+
+// Add adds entries to the multi bar.
+func (m *MultiBar) Add(entries ...MultiBarEntry) {
+	m.Config.Entries = append(m.Config.Entries, entries...)
+}
+
+// Entries returns the multi bar's entries.
+func (m *MultiBar) Entries() []MultiBarEntry {
+	return m.Config.Entries
+}
+
+// MaxPercent returns the highest percentage of the multi bar's available entries.
+func (m *MultiBar) MaxPercent() (float64, bool) {
+	max, found := 0.0, false
+	for _, entry := range m.Config.Entries {
+		if percent, ok := entry.Percent(); ok && (!found || percent > max) {
+			max, found = percent, true
+		}
+	}
+	return max, found
+}
+
+type multiBarMarshal MultiBar
+
+// MarshalJSON implements json.Marshaler.
+func (m *MultiBar) MarshalJSON() ([]byte, error) {
+	x := multiBarMarshal(*m)
+	x.Metadata.Type = typeMultiBar
+	return json.Marshal(&x)
+}
+
+// LessThan returns true if this component's highest percentage is less than the argument's.
+// Multi bars without available entries sort first.
+func (m *MultiBar) LessThan(i interface{}) bool {
+	v, ok := i.(*MultiBar)
+	if !ok {
+		return false
+	}
+
+	a, okA := m.MaxPercent()
+	b, okB := v.MaxPercent()
+
+	switch {
+	case !okA:
+		return okB
+	case !okB:
+		return false
+	default:
+		return a < b
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiBar_Marshal(t *testing.T) {
+	multiBar := NewMultiBar("Utilization", 90,
+		NewMultiBarEntry("cpu", 0.5, 2, "500m/2"),
+		NewUnavailableMultiBarEntry("pods"),
+	)
+
+	got, err := json.Marshal(multiBar)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "multiBar",
+    "title": [{"metadata": {"type": "text"}, "config": {"value": "Utilization"}}]
+  },
+  "config": {
+    "entries": [
+      {"label": "cpu", "value": 0.5, "max": 2, "text": "500m/2"},
+      {"label": "pods", "value": 0, "max": 0, "unavailable": true}
+    ],
+    "threshold": 90
+  }
+}`
+
+	assert.JSONEq(t, expected, string(got))
+}
+
+func TestMultiBarEntry_Percent(t *testing.T) {
+	percent, ok := NewMultiBarEntry("cpu", 1, 4, "").Percent()
+	require.True(t, ok)
+	assert.Equal(t, 25.0, percent)
+
+	_, ok = NewMultiBarEntry("cpu", 1, 0, "").Percent()
+	assert.False(t, ok)
+
+	_, ok = NewUnavailableMultiBarEntry("cpu").Percent()
+	assert.False(t, ok)
+}
+
+func TestMultiBar_LessThan(t *testing.T) {
+	low := NewMultiBar("", 90, NewMultiBarEntry("cpu", 1, 4, ""), NewMultiBarEntry("memory", 1, 2, ""))
+	high := NewMultiBar("", 90, NewMultiBarEntry("cpu", 3, 4, ""))
+	unavailable := NewMultiBar("", 90, NewUnavailableMultiBarEntry("cpu"))
+
+	cases := []struct {
+		name     string
+		a        *MultiBar
+		other    Component
+		expected bool
+	}{
+		{name: "is less", a: low, other: high, expected: true},
+		{name: "is not less", a: high, other: low, expected: false},
+		{name: "unavailable sorts first", a: unavailable, other: low, expected: true},
+		{name: "available is not less than unavailable", a: low, other: unavailable, expected: false},
+		{name: "other is not a multi bar", a: low, other: NewText("text"), expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.a.LessThan(tc.other))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// StatusBadge is a single state in a StatusBadges component.
+type StatusBadge struct {
+	// Label is the text displayed in the badge.
+	Label string `json:"label"`
+	// Status sets the styling of the badge.
+	Status TextStatus `json:"status,omitempty"`
+	// Message is additional detail for the badge, e.g. a condition message.
+	Message string `json:"message,omitempty"`
+}
+
+// StatusBadgesConfig is the contents of StatusBadges.
+type StatusBadgesConfig struct {
+	// Text is displayed before the badges.
+	Text string `json:"text,omitempty"`
+	// Badges are the states which are displayed.
+	Badges []StatusBadge `json:"badges"`
+}
+
+// StatusBadges is a component which renders a compound status as a row of badges.
+type StatusBadges struct {
+	base
+	Config StatusBadgesConfig `json:"config"`
+}
+
+var _ Component = (*StatusBadges)(nil)
+
+// NewStatusBadges creates a status badges component.
+func NewStatusBadges(text string, badges ...StatusBadge) *StatusBadges {
+	if badges == nil {
+		badges = []StatusBadge{}
+	}
+
+	return &StatusBadges{
+		base: newBase(typeStatusBadges, nil),
+		Config: StatusBadgesConfig{
+			Text:   text,
+			Badges: badges,
+		},
+	}
+}
+
+// Add adds a badge.
+func (sb *StatusBadges) Add(label string, status TextStatus, message string) {
+	sb.Config.Badges = append(sb.Config.Badges, StatusBadge{
+		Label:   label,
+		Status:  status,
+		Message: message,
+	})
+}
+
+// AddNeutral adds a badge without a status. It is styled neutrally.
+func (sb *StatusBadges) AddNeutral(label, message string) {
+	sb.Add(label, 0, message)
+}
+
+// Badges returns the badges.
+func (sb *StatusBadges) Badges() []StatusBadge {
+	return sb.Config.Badges
+}
+
+type statusBadgesMarshal StatusBadges
+
+// MarshalJSON implements json.Marshaler.
+func (sb *StatusBadges) MarshalJSON() ([]byte, error) {
+	m := statusBadgesMarshal(*sb)
+	m.Metadata.Type = typeStatusBadges
+	return json.Marshal(&m)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatusBadges_Marshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Component
+		expected string
+	}{
+		{
+			name:  "no badges",
+			input: NewStatusBadges(""),
+			expected: `
+			{
+				"metadata": {
+					"type": "statusBadges"
+				},
+				"config": {
+					"badges": []
+				}
+			}
+`,
+		},
+		{
+			name: "with badges",
+			input: NewStatusBadges("2/3",
+				StatusBadge{Label: "Available", Status: TextStatusOK},
+				StatusBadge{Label: "ReplicaFailure", Status: TextStatusError, Message: "exceeded quota"}),
+			expected: `
+			{
+				"metadata": {
+					"type": "statusBadges"
+				},
+				"config": {
+					"text": "2/3",
+					"badges": [
+						{"label": "Available", "status": 1},
+						{"label": "ReplicaFailure", "status": 3, "message": "exceeded quota"}
+					]
+				}
+			}
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func Test_StatusBadges_Add(t *testing.T) {
+	sb := NewStatusBadges("")
+	sb.Add("Complete", TextStatusOK, "")
+
+	expected := []StatusBadge{{Label: "Complete", Status: TextStatusOK}}
+	assert.Equal(t, expected, sb.Badges())
+}
+
+func Test_StatusBadges_AddNeutral(t *testing.T) {
+	sb := NewStatusBadges("")
+	sb.AddNeutral("Running", "1 active")
+
+	expected := []StatusBadge{{Label: "Running", Message: "1 active"}}
+	assert.Equal(t, expected, sb.Badges())
+}
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// TreeNode is a node in a Tree.
+type TreeNode struct {
+	// Label is the text displayed for the node.
+	Label string `json:"label"`
+	// Ref is an optional reference the node links to.
+	Ref string `json:"ref,omitempty"`
+	// Status sets the styling of the node.
+	Status TextStatus `json:"status,omitempty"`
+	// Collapsed hides the node's children until the node is expanded.
+	Collapsed bool `json:"collapsed,omitempty"`
+	// Truncated is true if the node has children which were not included.
+	Truncated bool `json:"truncated,omitempty"`
+	// Children are the node's child nodes.
+	Children []TreeNode `json:"children,omitempty"`
+}
+
+// NewTreeNode creates a tree node.
+func NewTreeNode(label, ref string, children ...TreeNode) TreeNode {
+	return TreeNode{
+		Label:    label,
+		Ref:      ref,
+		Children: children,
+	}
+}
+
+// Depth returns the number of levels in the tree rooted at the node.
+func (n TreeNode) Depth() int {
+	depth := 0
+	for _, child := range n.Children {
+		if d := child.Depth(); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
+
+// TreeConfig is the contents of Tree.
+type TreeConfig struct {
+	// Nodes are the root nodes of the tree.
+	Nodes []TreeNode `json:"nodes"`
+}
+
+// Tree is a component which renders hierarchical data as nested, collapsible nodes.
+type Tree struct {
+	base
+	Config TreeConfig `json:"config"`
+}
+
+var _ Component = (*Tree)(nil)
+
+// NewTree creates a tree component.
+func NewTree(title string, nodes ...TreeNode) *Tree {
+	if nodes == nil {
+		nodes = []TreeNode{}
+	}
+
+	return &Tree{
+		base: newBase(typeTree, TitleFromString(title)),
+		Config: TreeConfig{
+			Nodes: nodes,
+		},
+	}
+}
+
+// Add adds root nodes to the tree.
+func (t *Tree) Add(nodes ...TreeNode) {
+	t.Config.Nodes = append(t.Config.Nodes, nodes...)
+}
+
+// Nodes returns the root nodes of the tree.
+func (t *Tree) Nodes() []TreeNode {
+	return t.Config.Nodes
+}
+
+// Truncate removes nodes deeper than maxDepth. Nodes at maxDepth which had
+// children are marked as truncated.
+func (t *Tree) Truncate(maxDepth int) {
+	t.Config.Nodes = truncateTreeNodes(t.Config.Nodes, maxDepth)
+}
+
+func truncateTreeNodes(nodes []TreeNode, depth int) []TreeNode {
+	if depth <= 0 {
+		return nil
+	}
+
+	truncated := make([]TreeNode, len(nodes))
+	for i, node := range nodes {
+		if depth == 1 && len(node.Children) > 0 {
+			node.Truncated = true
+		}
+		node.Children = truncateTreeNodes(node.Children, depth-1)
+		truncated[i] = node
+	}
+
+	return truncated
+}
+
+type treeMarshal Tree
+
+// MarshalJSON implements json.Marshaler.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	m := treeMarshal(*t)
+	m.Metadata.Type = typeTree
+	return json.Marshal(&m)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTree() *Tree {
+	return NewTree("Owners",
+		NewTreeNode("deployment", "/deployment",
+			NewTreeNode("replica-set", "/replica-set",
+				NewTreeNode("pod-a", "/pod-a"),
+				NewTreeNode("pod-b", "/pod-b"),
+			),
+		),
+	)
+}
+
+func TestTree_Marshal(t *testing.T) {
+	tree := NewTree("Owners", NewTreeNode("deployment", "/deployment",
+		NewTreeNode("replica-set", ""),
+	))
+
+	got, err := json.Marshal(tree)
+	require.NoError(t, err)
+
+	expected := `
+{
+  "metadata": {
+    "type": "tree",
+    "title": [{"metadata": {"type": "text"}, "config": {"value": "Owners"}}]
+  },
+  "config": {
+    "nodes": [
+      {
+        "label": "deployment",
+        "ref": "/deployment",
+        "children": [{"label": "replica-set"}]
+      }
+    ]
+  }
+}`
+
+	assert.JSONEq(t, expected, string(got))
+}
+
+func TestTree_Add(t *testing.T) {
+	tree := NewTree("Owners")
+	assert.Empty(t, tree.Nodes())
+
+	tree.Add(NewTreeNode("a", ""), NewTreeNode("b", ""))
+	assert.Equal(t, []TreeNode{{Label: "a"}, {Label: "b"}}, tree.Nodes())
+}
+
+func TestTreeNode_Depth(t *testing.T) {
+	tree := createTestTree()
+	assert.Equal(t, 3, tree.Nodes()[0].Depth())
+	assert.Equal(t, 1, NewTreeNode("leaf", "").Depth())
+}
+
+func TestTree_Truncate(t *testing.T) {
+	tree := createTestTree()
+	tree.Truncate(2)
+
+	expected := []TreeNode{
+		{
+			Label: "deployment",
+			Ref:   "/deployment",
+			Children: []TreeNode{
+				{Label: "replica-set", Ref: "/replica-set", Truncated: true},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, tree.Nodes())
+
+	untouched := createTestTree()
+	untouched.Truncate(3)
+	assert.Equal(t, createTestTree().Nodes(), untouched.Nodes())
+}
@@ -93,6 +93,19 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeContainers, nil),
 			},
 		},
+		{
+			name:       "countdown",
+			configFile: "config_countdown.json",
+			objectType: "countdown",
+			expected: &Countdown{
+				Config: CountdownConfig{
+					Target:           1600000000,
+					WarningThreshold: 3600,
+					ErrorThreshold:   600,
+				},
+				base: newBase(typeCountdown, nil),
+			},
+		},
 		{
 			name:       "donutchart",
 			configFile: "config_donutchart.json",
@@ -353,6 +366,21 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeSingleStat, nil),
 			},
 		},
+		{
+			name:       "statusBadges",
+			configFile: "config_status_badges.json",
+			objectType: "statusBadges",
+			expected: &StatusBadges{
+				Config: StatusBadgesConfig{
+					Text: "2/3",
+					Badges: []StatusBadge{
+						{Label: "Available", Status: TextStatusOK},
+						{Label: "ReplicaFailure", Status: TextStatusError, Message: "exceeded quota"},
+					},
+				},
+				base: newBase(typeStatusBadges, nil),
+			},
+		},
 		{
 			name:       "summary",
 			configFile: "config_summary.json",
@@ -455,6 +483,40 @@ func Test_unmarshal(t *testing.T) {
 				base:   newBase(typeTimestamp, nil),
 			},
 		},
+		{
+			name:       "multi bar",
+			configFile: "config_multi_bar.json",
+			objectType: "multiBar",
+			expected: &MultiBar{
+				Config: MultiBarConfig{
+					Entries: []MultiBarEntry{
+						{Label: "cpu", Value: 0.5, Max: 2, Text: "500m/2"},
+						{Label: "pods", Unavailable: true},
+					},
+					Threshold: 90,
+				},
+				base: newBase(typeMultiBar, nil),
+			},
+		},
+		{
+			name:       "tree",
+			configFile: "config_tree.json",
+			objectType: "tree",
+			expected: &Tree{
+				Config: TreeConfig{
+					Nodes: []TreeNode{
+						{
+							Label: "deployment",
+							Ref:   "/deployment",
+							Children: []TreeNode{
+								{Label: "replicaSet", Ref: "/replicaSet", Collapsed: true, Truncated: true},
+							},
+						},
+					},
+				},
+				base: newBase(typeTree, nil),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,82 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+
+import { CountdownComponent, remainingText } from './countdown.component';
+import { CountdownView } from '../../../models/content';
+import { Status } from '../indicator/indicator.component';
+import { IndicatorComponent } from '../indicator/indicator.component';
+
+describe('CountdownComponent', () => {
+  let component: CountdownComponent;
+  let fixture: ComponentFixture<CountdownComponent>;
+
+  const now = new Date(1600000000000);
+
+  const countdownView = (target: number): CountdownView => ({
+    metadata: {
+      type: 'countdown',
+    },
+    config: {
+      target,
+      warningThreshold: 3600,
+      errorThreshold: 600,
+    },
+  });
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [CountdownComponent, IndicatorComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(CountdownComponent);
+    component = fixture.componentInstance;
+    component.view = countdownView(1600000000 + 7200);
+    component.ngOnChanges({
+      view: new SimpleChange(null, component.view, true),
+    });
+    fixture.detectChanges();
+  });
+
+  afterEach(() => {
+    component.ngOnDestroy();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('shows time remaining', () => {
+    component.update(now);
+    expect(component.text).toBe('expires in 2h');
+    expect(component.status).toBe(Status.Ok);
+  });
+
+  it('shows warnings and errors based on thresholds', () => {
+    component.view = countdownView(1600000000 + 1800);
+    component.update(now);
+    expect(component.status).toBe(Status.Warning);
+
+    component.view = countdownView(1600000000 + 300);
+    component.update(now);
+    expect(component.status).toBe(Status.Error);
+  });
+
+  it('shows expired targets', () => {
+    component.view = countdownView(1600000000 - 1);
+    component.update(now);
+    expect(component.text).toBe('expired');
+    expect(component.status).toBe(Status.Error);
+  });
+
+  it('formats remaining time', () => {
+    expect(remainingText(30)).toBe('30s');
+    expect(remainingText(90)).toBe('1m');
+    expect(remainingText(7200)).toBe('2h');
+    expect(remainingText(172800)).toBe('2d');
+  });
+});
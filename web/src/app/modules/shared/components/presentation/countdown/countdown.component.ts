@@ -0,0 +1,103 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import {
+  Component,
+  Input,
+  OnChanges,
+  OnDestroy,
+  SimpleChanges,
+} from '@angular/core';
+import { interval, Subscription } from 'rxjs';
+import { CountdownView, View } from 'src/app/modules/shared/models/content';
+import { Status } from '../indicator/indicator.component';
+
+const updateInterval = 1000;
+
+/**
+ * remainingText converts a number of seconds to a compact duration.
+ *
+ * @param seconds remaining seconds
+ */
+export const remainingText = (seconds: number): string => {
+  if (seconds >= 86400) {
+    return `${Math.floor(seconds / 86400)}d`;
+  } else if (seconds >= 3600) {
+    return `${Math.floor(seconds / 3600)}h`;
+  } else if (seconds >= 60) {
+    return `${Math.floor(seconds / 60)}m`;
+  }
+  return `${Math.floor(seconds)}s`;
+};
+
+@Component({
+  selector: 'app-view-countdown',
+  templateUrl: './countdown.component.html',
+  styleUrls: ['./countdown.component.scss'],
+})
+export class CountdownComponent implements OnChanges, OnDestroy {
+  v: CountdownView;
+
+  @Input() set view(v: View) {
+    this.v = v as CountdownView;
+  }
+  get view() {
+    return this.v;
+  }
+
+  text: string;
+  status: number;
+
+  private timer: Subscription;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      this.update();
+
+      if (!this.timer) {
+        this.timer = interval(updateInterval).subscribe(() => this.update());
+      }
+    }
+  }
+
+  ngOnDestroy(): void {
+    if (this.timer) {
+      this.timer.unsubscribe();
+      this.timer = null;
+    }
+  }
+
+  update(now: Date = new Date()) {
+    const config = this.v.config;
+    const remaining = config.target - now.getTime() / 1000;
+
+    if (remaining <= 0) {
+      this.text = 'expired';
+      this.status = Status.Error;
+      return;
+    }
+
+    this.text = `expires in ${remainingText(remaining)}`;
+
+    if (remaining <= (config.errorThreshold || 0)) {
+      this.status = Status.Error;
+    } else if (remaining <= (config.warningThreshold || 0)) {
+      this.status = Status.Warning;
+    } else {
+      this.status = Status.Ok;
+    }
+  }
+
+  statusClass(): string {
+    switch (this.status) {
+      case Status.Error:
+        return 'countdown-error';
+      case Status.Warning:
+        return 'countdown-warning';
+      default:
+        return 'countdown-ok';
+    }
+  }
+}
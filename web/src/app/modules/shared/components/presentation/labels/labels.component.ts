@@ -26,6 +26,7 @@ export class LabelsComponent implements OnChanges {
   title: string;
   labelKeys: string[];
   labels: { [key: string]: string };
+  links: { [key: string]: string };
   trackByIdentity = trackByIdentity;
 
   constructor(private viewService: ViewService) {}
@@ -40,6 +41,7 @@ export class LabelsComponent implements OnChanges {
 
         this.title = this.viewService.viewTitleAsText(view);
         this.labels = view.config.labels;
+        this.links = view.config.links;
 
         this.previousView = changes.view.currentValue;
       }
@@ -3,6 +3,7 @@
 //
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
 import { RouterTestingModule } from '@angular/router/testing';
 import { LinkComponent } from './link.component';
 import { SharedModule } from '../../../shared.module';
@@ -26,4 +27,19 @@ describe('LinkComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('should open absolute links in a new window', () => {
+    component.view = {
+      config: { value: 'lb.example.com', ref: 'http://lb.example.com' },
+      metadata: { type: 'link' },
+    };
+    component.ngOnChanges({
+      view: new SimpleChange(null, component.view, true),
+    });
+    fixture.detectChanges();
+
+    const link: HTMLAnchorElement = fixture.nativeElement.querySelector('a');
+    expect(link.getAttribute('href')).toBe('http://lb.example.com');
+    expect(link.getAttribute('target')).toBe('_blank');
+  });
 });
@@ -0,0 +1,63 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+import { MultiBarComponent } from './multi-bar.component';
+import { SharedModule } from '../../../shared.module';
+import { MultiBarView } from '../../../models/content';
+
+describe('MultiBarComponent', () => {
+  let component: MultiBarComponent;
+  let fixture: ComponentFixture<MultiBarComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [SharedModule],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(MultiBarComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('should render bars and unavailable entries', () => {
+    const view: MultiBarView = {
+      config: {
+        entries: [
+          { label: 'cpu', value: 1900, max: 2000, text: '1900m/2' },
+          { label: 'memory', value: 1, max: 4 },
+          { label: 'pods', value: 0, max: 0, unavailable: true },
+        ],
+        threshold: 90,
+      },
+      metadata: { type: 'multiBar' },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    expect(fixture.debugElement.queryAll(By.css('progress')).length).toBe(2);
+    expect(
+      fixture.debugElement.queryAll(By.css('.progress.danger')).length
+    ).toBe(1);
+    const unavailable = fixture.debugElement.queryAll(
+      By.css('.multi-bar-unavailable')
+    );
+    expect(unavailable.length).toBe(1);
+    expect(unavailable[0].nativeElement.textContent.trim()).toBe(
+      'unavailable'
+    );
+  });
+});
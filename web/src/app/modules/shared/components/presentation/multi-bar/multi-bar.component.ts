@@ -0,0 +1,65 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import {
+  MultiBarEntry,
+  MultiBarView,
+  View,
+} from 'src/app/modules/shared/models/content';
+
+interface Bar {
+  label: string;
+  text: string;
+  percent?: number;
+  exceeded: boolean;
+}
+
+@Component({
+  selector: 'app-view-multi-bar',
+  templateUrl: './multi-bar.component.html',
+  styleUrls: ['./multi-bar.component.scss'],
+})
+export class MultiBarComponent implements OnChanges {
+  private v: MultiBarView;
+
+  @Input() set view(v: View) {
+    this.v = v as MultiBarView;
+  }
+  get view() {
+    return this.v;
+  }
+
+  bars: Bar[] = [];
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as MultiBarView;
+      const threshold = view.config.threshold;
+      this.bars = (view.config.entries || []).map(entry =>
+        this.toBar(entry, threshold)
+      );
+    }
+  }
+
+  identifyBar(index: number, bar: Bar): string {
+    return `${bar.label}-${index}`;
+  }
+
+  private toBar(entry: MultiBarEntry, threshold?: number): Bar {
+    if (entry.unavailable || !entry.max || entry.max <= 0) {
+      return { label: entry.label, text: 'unavailable', exceeded: false };
+    }
+
+    const percent = (entry.value / entry.max) * 100;
+    return {
+      label: entry.label,
+      text: entry.text || `${Math.round(percent)}%`,
+      percent: Math.min(percent, 100),
+      exceeded: threshold > 0 && percent > threshold,
+    };
+  }
+}
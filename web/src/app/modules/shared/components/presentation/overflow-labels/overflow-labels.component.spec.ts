@@ -5,6 +5,8 @@
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 import { OverflowLabelsComponent } from './overflow-labels.component';
 import { By } from '@angular/platform-browser';
+import { Router } from '@angular/router';
+import { RouterTestingModule } from '@angular/router/testing';
 import { LabelFilterService } from '../../../services/label-filter/label-filter.service';
 
 describe('OverflowLabelsComponent', () => {
@@ -13,6 +15,7 @@ describe('OverflowLabelsComponent', () => {
 
   beforeEach(async(() => {
     TestBed.configureTestingModule({
+      imports: [RouterTestingModule],
       declarations: [OverflowLabelsComponent],
       providers: [LabelFilterService],
     }).compileComponents();
@@ -77,4 +80,21 @@ describe('OverflowLabelsComponent', () => {
       value: 'valueOne',
     });
   });
+
+  it('should navigate to the label link when the label has one', () => {
+    const debugElement = fixture.debugElement;
+    const labelFilterService = debugElement.injector.get(LabelFilterService);
+    const router = debugElement.injector.get(Router);
+    spyOn(labelFilterService, 'add');
+    spyOn(router, 'navigateByUrl');
+    component.links = {
+      ['keyOne']: '/pods?filters=keyOne%3AvalueOne',
+    };
+    component.filterLabel('keyOne', 'valueOne');
+
+    expect(router.navigateByUrl).toHaveBeenCalledWith(
+      '/pods?filters=keyOne%3AvalueOne'
+    );
+    expect(labelFilterService.add).not.toHaveBeenCalled();
+  });
 });
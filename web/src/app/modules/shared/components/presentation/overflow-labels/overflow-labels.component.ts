@@ -3,6 +3,7 @@
 //
 
 import { Component, Input, OnDestroy, OnInit } from '@angular/core';
+import { Router } from '@angular/router';
 import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
 import { LabelFilterService } from '../../../services/label-filter/label-filter.service';
 import { ContentService } from '../../../services/content/content.service';
@@ -38,6 +39,7 @@ export class OverflowLabelsComponent implements OnInit, OnDestroy {
   get labels(): Labels {
     return this.labelList;
   }
+  @Input() links: Labels;
 
   private labelList: Labels;
   showLabels: Labels[];
@@ -47,12 +49,17 @@ export class OverflowLabelsComponent implements OnInit, OnDestroy {
   private contentSubscription: Subscription;
 
   filterLabel(key: string, value: string) {
+    if (this.links && this.links[key]) {
+      this.router.navigateByUrl(this.links[key]);
+      return;
+    }
     this.labelFilter.add({ key, value });
   }
 
   constructor(
     private labelFilter: LabelFilterService,
-    private contentService: ContentService
+    private contentService: ContentService,
+    private router: Router
   ) {}
 
   ngOnInit() {
@@ -0,0 +1,58 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+
+import { StatusBadgesComponent } from './status-badges.component';
+import { StatusBadgesView } from '../../../models/content';
+import { Status } from '../indicator/indicator.component';
+
+describe('StatusBadgesComponent', () => {
+  let component: StatusBadgesComponent;
+  let fixture: ComponentFixture<StatusBadgesComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [StatusBadgesComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(StatusBadgesComponent);
+    component = fixture.componentInstance;
+    const view: StatusBadgesView = {
+      metadata: {
+        type: 'statusBadges',
+      },
+      config: {
+        text: '2/3',
+        badges: [
+          { label: 'Available', status: Status.Ok },
+          {
+            label: 'ReplicaFailure (FailedCreate)',
+            status: Status.Error,
+            message: 'exceeded quota',
+          },
+        ],
+      },
+    };
+    component.view = view;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('should render a badge for each state', () => {
+    const element: HTMLElement = fixture.nativeElement;
+    const badges = element.querySelectorAll('.badge');
+    expect(badges.length).toBe(2);
+    expect(badges[0].classList).toContain('badge-success');
+    expect(badges[1].classList).toContain('badge-danger');
+    expect(badges[1].getAttribute('title')).toBe('exceeded quota');
+    expect(element.querySelector('.status-badges-text').textContent).toBe(
+      '2/3'
+    );
+  });
+});
@@ -0,0 +1,44 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input } from '@angular/core';
+import {
+  StatusBadge,
+  StatusBadgesView,
+  View,
+} from 'src/app/modules/shared/models/content';
+import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
+import { Status } from '../indicator/indicator.component';
+
+@Component({
+  selector: 'app-view-status-badges',
+  templateUrl: './status-badges.component.html',
+  styleUrls: ['./status-badges.component.scss'],
+})
+export class StatusBadgesComponent {
+  v: StatusBadgesView;
+
+  @Input() set view(v: View) {
+    this.v = v as StatusBadgesView;
+  }
+  get view() {
+    return this.v;
+  }
+
+  trackByIdentity = trackByIdentity;
+
+  constructor() {}
+
+  badgeClass(badge: StatusBadge): string {
+    switch (badge.status) {
+      case Status.Ok:
+        return 'badge-success';
+      case Status.Warning:
+        return 'badge-warning';
+      case Status.Error:
+        return 'badge-danger';
+      default:
+        return 'badge-info';
+    }
+  }
+}
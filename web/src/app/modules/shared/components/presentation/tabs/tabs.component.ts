@@ -57,7 +57,8 @@ export class TabsComponent implements OnChanges, OnInit {
     if (fragment) {
       this.activeTab = fragment;
     } else {
-      this.activeTab = this.tabs[0]?.accessor;
+      const activeTab = this.tabs.find(tab => tab.view.metadata.active);
+      this.activeTab = (activeTab || this.tabs[0])?.accessor;
     }
   }
 
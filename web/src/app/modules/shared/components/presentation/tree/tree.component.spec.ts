@@ -0,0 +1,72 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+import { NoopAnimationsModule } from '@angular/platform-browser/animations';
+import { RouterTestingModule } from '@angular/router/testing';
+import { TreeComponent } from './tree.component';
+import { SharedModule } from '../../../shared.module';
+import { TreeView } from '../../../models/content';
+
+describe('TreeComponent', () => {
+  let component: TreeComponent;
+  let fixture: ComponentFixture<TreeComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [SharedModule, NoopAnimationsModule, RouterTestingModule],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(TreeComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('should render nested nodes', () => {
+    const view: TreeView = {
+      config: {
+        nodes: [
+          {
+            label: 'Deployment deployment',
+            ref: '/deployment',
+            children: [{ label: 'ReplicaSet replica-set', truncated: true }],
+          },
+        ],
+      },
+      metadata: { type: 'tree' },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    const links = fixture.debugElement.queryAll(By.css('a'));
+    expect(links.length).toBe(1);
+    expect(links[0].nativeElement.textContent.trim()).toBe(
+      'Deployment deployment'
+    );
+    expect(fixture.debugElement.queryAll(By.css('clr-tree-node')).length).toBe(
+      2
+    );
+    expect(
+      fixture.debugElement.queryAll(By.css('.tree-truncated')).length
+    ).toBe(1);
+  });
+
+  it('should collapse collapsed nodes', () => {
+    const node = { label: 'node', collapsed: true };
+    expect(component.isExpanded(node)).toBe(false);
+    expect(component.isExpanded({ label: 'node' })).toBe(true);
+  });
+});
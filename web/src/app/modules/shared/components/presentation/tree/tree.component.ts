@@ -0,0 +1,45 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import {
+  TreeNode,
+  TreeView,
+  View,
+} from 'src/app/modules/shared/models/content';
+
+@Component({
+  selector: 'app-view-tree',
+  templateUrl: './tree.component.html',
+  styleUrls: ['./tree.component.scss'],
+})
+export class TreeComponent implements OnChanges {
+  private v: TreeView;
+
+  @Input() set view(v: View) {
+    this.v = v as TreeView;
+  }
+  get view() {
+    return this.v;
+  }
+
+  nodes: TreeNode[] = [];
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as TreeView;
+      this.nodes = view.config.nodes || [];
+    }
+  }
+
+  isExpanded(node: TreeNode): boolean {
+    return !node.collapsed;
+  }
+
+  identifyNode(index: number, node: TreeNode): string {
+    return `${node.label}-${index}`;
+  }
+}
@@ -22,6 +22,7 @@ export interface Metadata {
   type: string;
   title?: View[];
   accessor?: string;
+  active?: boolean;
 }
 
 export interface View {
@@ -141,6 +142,7 @@ export interface GridActionsView extends View {
 export interface LabelsView extends View {
   config: {
     labels: { [key: string]: string };
+    links?: { [key: string]: string };
   };
 }
 
@@ -185,6 +187,19 @@ export interface SingleStatView extends View {
   };
 }
 
+export interface StatusBadge {
+  label: string;
+  status?: number;
+  message?: string;
+}
+
+export interface StatusBadgesView extends View {
+  config: {
+    text?: string;
+    badges: StatusBadge[];
+  };
+}
+
 export interface PodSummary {
   details: View[];
   status: string;
@@ -334,12 +349,50 @@ export interface TextView extends View {
   };
 }
 
+export interface CountdownView extends View {
+  config: {
+    target: number;
+    warningThreshold?: number;
+    errorThreshold?: number;
+  };
+}
+
 export interface TimestampView extends View {
   config: {
     timestamp: number;
   };
 }
 
+export interface MultiBarEntry {
+  label: string;
+  value: number;
+  max: number;
+  text?: string;
+  unavailable?: boolean;
+}
+
+export interface MultiBarView extends View {
+  config: {
+    entries: MultiBarEntry[];
+    threshold?: number;
+  };
+}
+
+export interface TreeNode {
+  label: string;
+  ref?: string;
+  status?: number;
+  collapsed?: boolean;
+  truncated?: boolean;
+  children?: TreeNode[];
+}
+
+export interface TreeView extends View {
+  config: {
+    nodes: TreeNode[];
+  };
+}
+
 export interface DonutSegment {
   count: number;
   status: string;
@@ -10,6 +10,7 @@ import { BreadcrumbComponent } from './components/presentation/breadcrumb/breadc
 import { CardComponent } from './components/presentation/card/card.component';
 import { CardListComponent } from './components/presentation/card-list/card-list.component';
 import { CodeComponent } from './components/presentation/code/code.component';
+import { CountdownComponent } from './components/presentation/countdown/countdown.component';
 import { LabelsComponent } from './components/presentation/labels/labels.component';
 import { LinkComponent } from './components/presentation/link/link.component';
 import { ListComponent } from './components/presentation/list/list.component';
@@ -19,6 +20,7 @@ import { DatagridComponent } from './components/presentation/datagrid/datagrid.c
 import { DonutChartComponent } from './components/presentation/donut-chart/donut-chart.component';
 import { FlexlayoutComponent } from './components/presentation/flexlayout/flexlayout.component';
 import { SingleStatComponent } from './components/presentation/single-stat/single-stat.component';
+import { StatusBadgesComponent } from './components/presentation/status-badges/status-badges.component';
 import { QuadrantComponent } from './components/presentation/quadrant/quadrant.component';
 import { IFrameComponent } from './components/presentation/iframe/iframe.component';
 import { EditorComponent } from './components/smart/editor/editor.component';
@@ -29,7 +31,9 @@ import { ButtonGroupComponent } from './components/presentation/button-group/but
 import { YamlComponent } from './components/presentation/yaml/yaml.component';
 import { TableComponent } from './components/presentation/table/table.component';
 import { TimestampComponent } from './components/presentation/timestamp/timestamp.component';
+import { TreeComponent } from './components/presentation/tree/tree.component';
 import { LoadingComponent } from './components/presentation/loading/loading.component';
+import { MultiBarComponent } from './components/presentation/multi-bar/multi-bar.component';
 import { HighlightModule } from 'ngx-highlightjs';
 import { LabelSelectorComponent } from './components/presentation/label-selector/label-selector.component';
 import { CytoscapeComponent } from './components/presentation/cytoscape/cytoscape.component';
@@ -80,6 +84,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     CardComponent,
     CardListComponent,
     CodeComponent,
+    CountdownComponent,
     ContainersComponent,
     ContentFilterComponent,
     ContentSwitcherComponent,
@@ -109,6 +114,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     ListComponent,
     LoadingComponent,
     LogsComponent,
+    MultiBarComponent,
     ObjectStatusComponent,
     PodStatusComponent,
     PortForwardComponent,
@@ -122,6 +128,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     SelectorsComponent,
     SingleStatComponent,
     SliderViewComponent,
+    StatusBadgesComponent,
     SummaryComponent,
     TableComponent,
     TabsComponent,
@@ -129,6 +136,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     TextComponent,
     TimestampComponent,
     TitleComponent,
+    TreeComponent,
     YamlComponent,
     OverflowLabelsComponent,
     OverflowSelectorsComponent,
@@ -160,6 +168,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     CardComponent,
     CardListComponent,
     CodeComponent,
+    CountdownComponent,
     ContainersComponent,
     ContentFilterComponent,
     ContentSwitcherComponent,
@@ -187,6 +196,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     ListComponent,
     LoadingComponent,
     LogsComponent,
+    MultiBarComponent,
     ObjectStatusComponent,
     PodStatusComponent,
     PortForwardComponent,
@@ -196,6 +206,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     SelectorsComponent,
     SliderViewComponent,
     SingleStatComponent,
+    StatusBadgesComponent,
     SummaryComponent,
     TableComponent,
     TabsComponent,
@@ -203,6 +214,7 @@ import { ContentTextFilterComponent } from './components/presentation/content-te
     TextComponent,
     TimestampComponent,
     TitleComponent,
+    TreeComponent,
     YamlComponent,
     OverflowLabelsComponent,
     PreferencesComponent,